@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// apiCallLoggingEnvVar enables structured logging of AWS API calls and sets its log level.
+	apiCallLoggingEnvVar = "TF_LOG_PROVIDER_AWS_JSON"
+	// apiCallLoggingSubsystem is the tflog subsystem that AWS API calls are logged to.
+	apiCallLoggingSubsystem = "aws-api-call"
+)
+
+var (
+	// accessKeyIDRegexp matches AWS access key IDs, which can appear in error messages such as SignatureDoesNotMatch.
+	accessKeyIDRegexp = regexache.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)
+)
+
+// apiCallLoggingEnabled returns whether structured logging of AWS API calls is enabled.
+func apiCallLoggingEnabled() bool {
+	v := os.Getenv(apiCallLoggingEnvVar)
+
+	return v != "" && !strings.EqualFold(v, "off")
+}
+
+// apiCall is the summary of a single AWS API call, including any retries.
+type apiCall struct {
+	service       string
+	operation     string
+	requestID     string
+	start         time.Time
+	retryCount    int
+	throttleCount int
+	err           error
+}
+
+// log writes the API call summary as a single structured log entry.
+func (c *apiCall) log(ctx context.Context) {
+	ctx = tflog.NewSubsystem(ctx, apiCallLoggingSubsystem, tflog.WithLevelFromEnv(apiCallLoggingEnvVar))
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, apiCallLoggingSubsystem, accessKeyIDRegexp)
+
+	fields := map[string]any{
+		"aws.service":        c.service,
+		"aws.operation":      c.operation,
+		"aws.request_id":     c.requestID,
+		"aws.latency_ms":     time.Since(c.start).Milliseconds(),
+		"aws.retry_count":    c.retryCount,
+		"aws.throttle_count": c.throttleCount,
+	}
+
+	if c.err != nil {
+		fields["error"] = c.err.Error()
+		tflog.SubsystemWarn(ctx, apiCallLoggingSubsystem, "AWS API call failed", fields)
+	} else {
+		tflog.SubsystemInfo(ctx, apiCallLoggingSubsystem, "AWS API call", fields)
+	}
+}
+
+// apiCallLoggingMiddleware logs each AWS SDK for Go v2 API call once all its attempts have completed.
+// It runs in the Initialize step, after the service metadata is registered and before the retry loop.
+func apiCallLoggingMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAPICallLogging", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		call := &apiCall{
+			service:   awsmiddleware.GetServiceID(ctx),
+			operation: awsmiddleware.GetOperationName(ctx),
+			start:     time.Now(),
+		}
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		call.err = err
+		call.requestID, _ = awsmiddleware.GetRequestIDMetadata(metadata)
+		if call.requestID == "" {
+			var re *awshttp.ResponseError
+			if errors.As(err, &re) {
+				call.requestID = re.ServiceRequestID()
+			}
+		}
+		if results, ok := retry.GetAttemptResults(metadata); ok {
+			if n := len(results.Results); n > 0 {
+				call.retryCount = n - 1
+			}
+			for _, v := range results.Results {
+				if v.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(v.Err) == aws_sdkv2.TrueTernary {
+					call.throttleCount++
+				}
+			}
+		}
+
+		call.log(ctx)
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+type apiCallContextKey struct{}
+
+// addAPICallLoggingHandlers adds request handlers that log each AWS SDK for Go v1 API call once all its attempts have completed.
+func addAPICallLoggingHandlers(sess *session_sdkv1.Session) {
+	sess.Handlers.Validate.PushFront(func(r *request_sdkv1.Request) {
+		r.SetContext(context.WithValue(r.Context(), apiCallContextKey{}, &apiCall{
+			service:   r.ClientInfo.ServiceID,
+			operation: r.Operation.Name,
+			start:     time.Now(),
+		}))
+	})
+	sess.Handlers.Retry.PushBack(func(r *request_sdkv1.Request) {
+		if call, ok := r.Context().Value(apiCallContextKey{}).(*apiCall); ok && request_sdkv1.IsErrorThrottle(r.Error) {
+			call.throttleCount++
+		}
+	})
+	sess.Handlers.Complete.PushBack(func(r *request_sdkv1.Request) {
+		if call, ok := r.Context().Value(apiCallContextKey{}).(*apiCall); ok {
+			call.requestID = r.RequestID
+			call.retryCount = r.RetryCount
+			call.err = r.Error
+			call.log(r.Context())
+		}
+	})
+}
@@ -9,14 +9,18 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"reflect"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
@@ -27,6 +31,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// defaultListConcurrency is the maximum number of concurrent API requests made when listing resources if not configured.
+	defaultListConcurrency = 4
+)
+
 type AWSClient struct {
 	AccountID         string
 	DefaultTagsConfig *tftags.DefaultConfig
@@ -35,19 +44,30 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	assumeRoleSessionTags          map[string]string // From provider configuration.
+	assumeRoleSourceIdentity       string            // From provider configuration.
+	awsConfig                      *aws_sdkv2.Config
+	batchTagReads                  bool // From provider configuration.
+	clients                        map[string]any
+	conns                          map[string]any
+	deletionProtectedResourceTypes []string // From provider configuration.
+	dnsSuffix                      string
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	iamPropagationTimeout          time.Duration // From provider configuration.
+	listConcurrency                int           // From provider configuration.
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	session                        *session_sdkv1.Session
+	s3ExpressClient                *s3_sdkv2.Client
+	s3UsePathStyle                 bool            // From provider configuration.
+	s3USEast1RegionalEndpoint      string          // From provider configuration.
+	serviceMaxRetries              map[string]int  // From provider configuration.
+	serviceUseDualStackEndpoint    map[string]bool // From provider configuration.
+	serviceUseFIPSEndpoint         map[string]bool // From provider configuration.
+	stsRegion                      string          // From provider configuration.
+	tagsBatcher                    *tagsBatcher
+	validateServiceRegions         bool // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -136,6 +156,36 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// ListConcurrency returns the maximum number of concurrent API requests made when listing resources.
+func (c *AWSClient) ListConcurrency(context.Context) int {
+	if c.listConcurrency < 1 {
+		return defaultListConcurrency
+	}
+
+	return c.listConcurrency
+}
+
+// IAMPropagationTimeout returns the iam_propagation_timeout provider configuration value.
+// A zero value indicates that the value was not configured.
+func (c *AWSClient) IAMPropagationTimeout(context.Context) time.Duration {
+	return c.iamPropagationTimeout
+}
+
+// AssumeRoleSessionTags returns the session tags from the provider's assume_role configuration.
+func (c *AWSClient) AssumeRoleSessionTags(context.Context) map[string]string {
+	return c.assumeRoleSessionTags
+}
+
+// AssumeRoleSourceIdentity returns the source identity from the provider's assume_role configuration.
+func (c *AWSClient) AssumeRoleSourceIdentity(context.Context) string {
+	return c.assumeRoleSourceIdentity
+}
+
+// IsDeletionProtected returns whether the provider configuration prevents resources of the specified type from being destroyed.
+func (c *AWSClient) IsDeletionProtected(_ context.Context, typeName string) bool {
+	return slices.Contains(c.deletionProtectedResourceTypes, typeName)
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -242,6 +292,46 @@ func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
+// APIClient returns the default AWS API client for the specified service.
+// The AWS SDK for Go v2 client is returned if the service has one, otherwise the AWS SDK for Go v1 client.
+// It is intended for generic use, e.g. by data sources that invoke arbitrary API operations;
+// service implementations should use the typed accessor methods.
+func (c *AWSClient) APIClient(ctx context.Context, servicePackageName string) (any, error) {
+	providerNameUpper, err := names.ProviderNameUpper(servicePackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(c)
+	for _, suffix := range []string{"Client", "Conn"} {
+		if m := v.MethodByName(providerNameUpper + suffix); m.IsValid() {
+			return m.Call([]reflect.Value{reflect.ValueOf(ctx)})[0].Interface(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no AWS API client: %s", servicePackageName)
+}
+
+// RegionForContext returns the AWS Region for API calls made with the specified Context.
+// This is the provider's Region unless the resource's Region has been overridden.
+func (c *AWSClient) RegionForContext(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok && v.OverrideRegion != "" {
+		return v.OverrideRegion
+	}
+
+	return c.Region
+}
+
+// apiClientCacheKey returns the key under which the default API client for the specified service is cached.
+// API clients for overridden Regions are cached separately.
+func (c *AWSClient) apiClientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.RegionForContext(ctx); region != c.Region {
+		return servicePackageName + "/" + region
+	}
+
+	return servicePackageName
+}
+
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
@@ -250,6 +340,45 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	region := c.RegionForContext(ctx)
+	maxRetries, okMaxRetries := c.serviceMaxRetries[servicePackageName]
+	useDualStackEndpoint, okUseDualStackEndpoint := c.serviceUseDualStackEndpoint[servicePackageName]
+	useFIPSEndpoint, okUseFIPSEndpoint := c.serviceUseFIPSEndpoint[servicePackageName]
+	if region != c.Region || okMaxRetries || okUseDualStackEndpoint || okUseFIPSEndpoint {
+		cfg := c.awsConfig.Copy()
+		cfgV1 := aws_sdkv1.NewConfig()
+		if region != c.Region {
+			cfg.Region = region
+			cfgV1.WithRegion(region)
+		}
+		if okMaxRetries {
+			cfg.RetryMaxAttempts = maxRetries
+			cfgV1.WithMaxRetries(maxRetries)
+		}
+		if okUseDualStackEndpoint || okUseFIPSEndpoint {
+			var source endpointStateConfigSource
+			if okUseDualStackEndpoint {
+				source.useDualStackEndpoint = aws_sdkv2.DualStackEndpointStateDisabled
+				cfgV1.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateDisabled
+				if useDualStackEndpoint {
+					source.useDualStackEndpoint = aws_sdkv2.DualStackEndpointStateEnabled
+					cfgV1.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateEnabled
+				}
+			}
+			if okUseFIPSEndpoint {
+				source.useFIPSEndpoint = aws_sdkv2.FIPSEndpointStateDisabled
+				cfgV1.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateDisabled
+				if useFIPSEndpoint {
+					source.useFIPSEndpoint = aws_sdkv2.FIPSEndpointStateEnabled
+					cfgV1.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateEnabled
+				}
+			}
+			// AWS SDK for Go v2 API clients use the first configuration source that specifies a value.
+			cfg.ConfigSources = append([]any{source}, cfg.ConfigSources...)
+		}
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = c.session.Copy(cfgV1)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -326,12 +455,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -375,7 +505,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
@@ -388,12 +518,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -428,8 +559,22 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// endpointStateConfigSource is an AWS SDK for Go v2 configuration source that overrides the FIPS and dual-stack endpoint settings.
+type endpointStateConfigSource struct {
+	useDualStackEndpoint aws_sdkv2.DualStackEndpointState
+	useFIPSEndpoint      aws_sdkv2.FIPSEndpointState
+}
+
+func (s endpointStateConfigSource) GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error) {
+	return s.useDualStackEndpoint, s.useDualStackEndpoint != aws_sdkv2.DualStackEndpointStateUnset, nil
+}
+
+func (s endpointStateConfigSource) GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error) {
+	return s.useFIPSEndpoint, s.useFIPSEndpoint != aws_sdkv2.FIPSEndpointStateUnset, nil
+}
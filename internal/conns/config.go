@@ -11,6 +11,8 @@ import (
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	organizations_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -29,10 +31,16 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedOUPaths                 []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagReads                  bool
+	ClientCertificate              string
+	ClientPrivateKey               string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionProtectedResourceTypes []string
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -40,8 +48,10 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPropagationTimeout          time.Duration
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	ListConcurrency                int
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -50,6 +60,9 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceMaxRetries              map[string]int
+	ServiceUseDualStackEndpoint    map[string]bool
+	ServiceUseFIPSEndpoint         map[string]bool
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -62,6 +75,7 @@ type Config struct {
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	ValidateServiceRegions         bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -122,6 +136,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.CustomCABundle = c.CustomCABundle
 	}
 
+	if c.ClientCertificate != "" || c.ClientPrivateKey != "" {
+		httpClient, err := c.newTLSClientCertificateHTTPClient()
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring TLS client certificate: %s", err)
+		}
+
+		// The CA bundle is already applied to the HTTP client.
+		awsbaseConfig.CustomCABundle = ""
+		awsbaseConfig.HTTPClient = httpClient
+	}
+
 	if c.EC2MetadataServiceEndpoint != "" {
 		awsbaseConfig.EC2MetadataServiceEndpoint = c.EC2MetadataServiceEndpoint
 		awsbaseConfig.EC2MetadataServiceEndpointMode = c.EC2MetadataServiceEndpointMode
@@ -145,7 +170,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	awsbaseConfig.SkipCredsValidation = true
 
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
-	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
+	ctx, cfg, awsDiags := getAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
 		diags = append(diags, diag.Diagnostic{
@@ -183,6 +208,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if apiCallLoggingEnabled() {
+		cfg.APIOptions = append(cfg.APIOptions, apiCallLoggingMiddleware)
+		addAPICallLoggingHandlers(session)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -210,7 +240,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.batchTagReads = c.BatchTagReads
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.deletionProtectedResourceTypes = c.DeletionProtectedResourceTypes
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
@@ -218,19 +250,67 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
+		client.assumeRoleSessionTags = c.AssumeRole.Tags
+		client.assumeRoleSourceIdentity = c.AssumeRole.SourceIdentity
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.iamPropagationTimeout = c.IAMPropagationTimeout
+	client.listConcurrency = c.ListConcurrency
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceMaxRetries = c.ServiceMaxRetries
+	client.serviceUseDualStackEndpoint = c.ServiceUseDualStackEndpoint
+	client.serviceUseFIPSEndpoint = c.ServiceUseFIPSEndpoint
 	client.stsRegion = c.STSRegion
+	client.validateServiceRegions = c.ValidateServiceRegions
+
+	if err := verifyOrganizationAllowed(ctx, client.OrganizationsClient(ctx), accountID, c.AllowedOrganizationIDs, c.AllowedOUPaths); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v := c.DefaultTagsConfig; v != nil && v.TagPolicyCompliance != "" && v.TagPolicyCompliance != tftags.TagPolicyComplianceDisabled {
+		tflog.Debug(ctx, "Retrieving effective AWS Organizations tag policy")
+		policy, err := findEffectiveTagPolicy(ctx, client.OrganizationsClient(ctx))
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "reading effective AWS Organizations tag policy: %s", err)
+		}
+
+		v.TagPolicy = policy
+	}
 
 	return client, diags
 }
 
+// findEffectiveTagPolicy returns the tag policy in effect for the caller's account.
+// nil is returned if the account is not a member of an organization or has no tag policy.
+func findEffectiveTagPolicy(ctx context.Context, conn *organizations_sdkv2.Client) (*tftags.Policy, error) {
+	output, err := conn.DescribeEffectivePolicy(ctx, &organizations_sdkv2.DescribeEffectivePolicyInput{
+		PolicyType: organizationstypes_sdkv2.EffectivePolicyTypeTagPolicy,
+	})
+
+	if errs.IsA[*organizationstypes_sdkv2.AWSOrganizationsNotInUseException](err) || errs.IsA[*organizationstypes_sdkv2.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return tftags.ParsePolicy(aws_sdkv2.ToString(output.EffectivePolicy.PolicyContent))
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
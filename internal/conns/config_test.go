@@ -8,13 +8,18 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -508,3 +513,181 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestTLSClientCertificateConfig(t *testing.T) {
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "client.key")
+	certificateFile := filepath.Join(dir, "client.crt")
+	if err := os.WriteFile(keyFile, []byte(key), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certificateFile, []byte(certificate), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		config               map[string]any
+		environmentVariables map[string]string
+		expectError          bool
+	}{
+		"client certificate": {
+			config: map[string]any{
+				"client_certificate": certificateFile,
+				"client_private_key": keyFile,
+				"https_proxy":        "http://https-proxy.example.com:8080",
+				"no_proxy":           "dont-proxy.example.com",
+			},
+		},
+		"client certificate with CA bundle": {
+			config: map[string]any{
+				"client_certificate": certificateFile,
+				"client_private_key": keyFile,
+				"custom_ca_bundle":   certificateFile,
+			},
+		},
+		"invalid private key": {
+			config: map[string]any{
+				"client_certificate": certificateFile,
+				"client_private_key": certificateFile,
+			},
+			expectError: true,
+		},
+		"AWS_CA_BUNDLE environment variable": {
+			config: map[string]any{
+				"client_certificate": certificateFile,
+				"client_private_key": keyFile,
+			},
+			environmentVariables: map[string]string{
+				"AWS_CA_BUNDLE": certificateFile,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			config := map[string]any{
+				"access_key":                  "StaticAccessKey",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			for k, v := range tc.environmentVariables {
+				t.Setenv(k, v)
+			}
+
+			maps.Copy(config, tc.config)
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if got, want := diags.HasError(), tc.expectError; got != want {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if tc.expectError {
+				return
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			client, ok := meta.AwsConfig(ctx).HTTPClient.(*http.Client)
+			if !ok {
+				t.Fatalf("expected http.Client, got %T", meta.AwsConfig(ctx).HTTPClient)
+			}
+			transport, ok := client.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("expected http.Transport, got %T", client.Transport)
+			}
+
+			if got, want := len(transport.TLSClientConfig.Certificates), 1; got != want {
+				t.Errorf("client certificates = %d, want %d", got, want)
+			}
+
+			if _, ok := tc.config["custom_ca_bundle"]; ok && transport.TLSClientConfig.RootCAs == nil {
+				t.Error("expected custom root CAs")
+			}
+
+			if v, ok := tc.config["https_proxy"]; ok {
+				req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+				if pUrl, err := transport.Proxy(req); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				} else if pUrl == nil || pUrl.String() != v {
+					t.Errorf("expected proxy %q, got %v", v, pUrl)
+				}
+
+				req, _ = http.NewRequest(http.MethodGet, "https://dont-proxy.example.com", nil)
+				if pUrl, err := transport.Proxy(req); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				} else if pUrl != nil {
+					t.Errorf("expected no proxy, got %q", pUrl.String())
+				}
+			}
+		})
+	}
+}
+
+func TestServiceEndpointStateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	config := map[string]any{
+		"access_key":                  "StaticAccessKey",
+		"secret_key":                  servicemocks.MockStaticSecretKey,
+		"region":                      "us-west-2",
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+		"use_fips_endpoint":           true,
+		"endpoints": []any{
+			map[string]any{
+				"use_dualstack_endpoint": map[string]any{
+					"ec2": true,
+				},
+				"use_fips_endpoint": map[string]any{
+					"directconnect": false,
+					"ec2":           false,
+				},
+			},
+		},
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	// AWS SDK for Go v2.
+	ec2Options := meta.EC2Client(ctx).Options()
+	if got, want := ec2Options.EndpointOptions.UseFIPSEndpoint, aws.FIPSEndpointStateDisabled; got != want {
+		t.Errorf("EC2 UseFIPSEndpoint = %v, want %v", got, want)
+	}
+	if got, want := ec2Options.EndpointOptions.UseDualStackEndpoint, aws.DualStackEndpointStateEnabled; got != want {
+		t.Errorf("EC2 UseDualStackEndpoint = %v, want %v", got, want)
+	}
+
+	if got, want := meta.IAMClient(ctx).Options().EndpointOptions.UseFIPSEndpoint, aws.FIPSEndpointStateEnabled; got != want {
+		t.Errorf("IAM UseFIPSEndpoint = %v, want %v", got, want)
+	}
+
+	// AWS SDK for Go v1.
+	if got, want := meta.DirectConnectConn(ctx).Config.UseFIPSEndpoint, endpoints_sdkv1.FIPSEndpointStateDisabled; got != want {
+		t.Errorf("Direct Connect UseFIPSEndpoint = %v, want %v", got, want)
+	}
+}
@@ -19,6 +19,12 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an optional interface implemented by service packages
+// that contain Terraform Plugin Framework ephemeral resources.
+type ServicePackageWithEphemeralResources interface {
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
 type (
 	contextKeyType int
 )
@@ -30,6 +36,7 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	OverrideRegion     string // AWS Region overriding the provider's Region, if any
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
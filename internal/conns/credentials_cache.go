@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumedRoleCredentials holds the assumed role credentials shared by all provider configurations in this process,
+// i.e. by all the provider aliases in a single Terraform run.
+var assumedRoleCredentials = &credentialsCache{
+	entries: make(map[string]*credentialsCacheEntry),
+}
+
+type credentialsCache struct {
+	lock    sync.Mutex
+	entries map[string]*credentialsCacheEntry
+}
+
+// credentialsCacheEntry's lock is held while the role is assumed so that
+// concurrently configured providers wait for, and then share, the credentials.
+type credentialsCacheEntry struct {
+	lock     sync.Mutex
+	provider aws_sdkv2.CredentialsProvider
+}
+
+func (c *credentialsCache) entry(key string) *credentialsCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &credentialsCacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
+
+// getAwsConfig calls awsbase.GetAwsConfig, sharing the assumed role credentials between provider configurations
+// with the same source credentials and assume role parameters so that the role is only assumed once.
+func getAwsConfig(ctx context.Context, awsbaseConfig *awsbase.Config) (context.Context, aws_sdkv2.Config, basediag.Diagnostics) {
+	if awsbaseConfig.AssumeRole == nil {
+		return awsbase.GetAwsConfig(ctx, awsbaseConfig)
+	}
+
+	key, err := assumeRoleCredentialsKey(awsbaseConfig)
+
+	if err != nil {
+		tflog.Debug(ctx, "not sharing assumed role credentials", map[string]any{
+			"error": err.Error(),
+		})
+		return awsbase.GetAwsConfig(ctx, awsbaseConfig)
+	}
+
+	entry := assumedRoleCredentials.entry(key)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.provider == nil {
+		ctx, cfg, diags := awsbase.GetAwsConfig(ctx, awsbaseConfig)
+
+		if !diags.HasError() {
+			entry.provider = cfg.Credentials
+		}
+
+		return ctx, cfg, diags
+	}
+
+	tflog.Info(ctx, "Using shared assumed role credentials", map[string]any{
+		"tf_aws.assume_role.role_arn": awsbaseConfig.AssumeRole.RoleARN,
+	})
+
+	// Resolve the configuration with the source credentials only, then use the shared credentials.
+	// The credentials are refreshed as needed by the provider configuration that first assumed the role.
+	sourceConfig := *awsbaseConfig
+	sourceConfig.AssumeRole = nil
+
+	ctx, cfg, diags := awsbase.GetAwsConfig(ctx, &sourceConfig)
+
+	if diags.HasError() {
+		return ctx, cfg, diags
+	}
+
+	cfg.Credentials = entry.provider
+
+	return ctx, cfg, diags
+}
+
+// assumeRoleCredentialsKey returns a key that identifies the credentials obtained by assuming the role.
+// It covers the source credential configuration and the assume role parameters, but not settings such as
+// the Region that do not affect the credentials.
+func assumeRoleCredentialsKey(awsbaseConfig *awsbase.Config) (string, error) {
+	v := struct {
+		AccessKey                      string
+		AssumeRole                     *awsbase.AssumeRole
+		AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+		EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
+		EC2MetadataServiceEndpoint     string
+		EC2MetadataServiceEndpointMode string
+		Profile                        string
+		SecretKey                      string
+		SharedConfigFiles              []string
+		SharedCredentialsFiles         []string
+		SsoEndpoint                    string
+		StsEndpoint                    string
+		Token                          string
+	}{
+		AccessKey:                      awsbaseConfig.AccessKey,
+		AssumeRole:                     awsbaseConfig.AssumeRole,
+		AssumeRoleWithWebIdentity:      awsbaseConfig.AssumeRoleWithWebIdentity,
+		EC2MetadataServiceEnableState:  awsbaseConfig.EC2MetadataServiceEnableState,
+		EC2MetadataServiceEndpoint:     awsbaseConfig.EC2MetadataServiceEndpoint,
+		EC2MetadataServiceEndpointMode: awsbaseConfig.EC2MetadataServiceEndpointMode,
+		Profile:                        awsbaseConfig.Profile,
+		SecretKey:                      awsbaseConfig.SecretKey,
+		SharedConfigFiles:              awsbaseConfig.SharedConfigFiles,
+		SharedCredentialsFiles:         awsbaseConfig.SharedCredentialsFiles,
+		SsoEndpoint:                    awsbaseConfig.SsoEndpoint,
+		StsEndpoint:                    awsbaseConfig.StsEndpoint,
+		Token:                          awsbaseConfig.Token,
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The key contains secrets, only its hash is kept.
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleCredentialsKey(t *testing.T) {
+	t.Parallel()
+
+	base := awsbase.Config{
+		AccessKey: "AKIAEXAMPLE",
+		AssumeRole: &awsbase.AssumeRole{
+			RoleARN:     "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			SessionName: "session",
+			Tags: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		Region:    "us-west-2", //lintignore:AWSAT003
+		SecretKey: "secret",
+	}
+
+	testCases := map[string]struct {
+		config func(awsbase.Config) awsbase.Config
+		same   bool
+	}{
+		"identical": {
+			config: func(c awsbase.Config) awsbase.Config { return c },
+			same:   true,
+		},
+		"different Region": {
+			config: func(c awsbase.Config) awsbase.Config {
+				c.Region = "eu-west-1" //lintignore:AWSAT003
+				return c
+			},
+			same: true,
+		},
+		"different role": {
+			config: func(c awsbase.Config) awsbase.Config {
+				ar := *c.AssumeRole
+				ar.RoleARN = "arn:aws:iam::123456789012:role/other" //lintignore:AWSAT005
+				c.AssumeRole = &ar
+				return c
+			},
+		},
+		"different session tags": {
+			config: func(c awsbase.Config) awsbase.Config {
+				ar := *c.AssumeRole
+				ar.Tags = map[string]string{
+					"key1": "value1",
+				}
+				c.AssumeRole = &ar
+				return c
+			},
+		},
+		"different source credentials": {
+			config: func(c awsbase.Config) awsbase.Config {
+				c.SecretKey = "other"
+				return c
+			},
+		},
+		"different profile": {
+			config: func(c awsbase.Config) awsbase.Config {
+				c.AccessKey, c.SecretKey = "", ""
+				c.Profile = "other"
+				return c
+			},
+		},
+	}
+
+	want, err := assumeRoleCredentialsKey(&base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testCase.config(base)
+			got, err := assumeRoleCredentialsKey(&config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if same := got == want; same != testCase.same {
+				t.Errorf("same key = %t, want %t", same, testCase.same)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// newTLSClientCertificateHTTPClient returns an HTTP client that presents the configured TLS client certificate.
+// aws-sdk-go-base cannot configure client certificates, so the proxy, custom CA bundle and insecure
+// settings it would otherwise apply are applied here in the same way.
+func (c *Config) newTLSClientCertificateHTTPClient() (*http.Client, error) {
+	if c.ClientCertificate == "" || c.ClientPrivateKey == "" {
+		return nil, errors.New("both client_certificate and client_private_key must be configured")
+	}
+
+	// The AWS SDK for Go v2 cannot add the AWS_CA_BUNDLE environment variable's certificates to a custom HTTP client.
+	if os.Getenv("AWS_CA_BUNDLE") != "" {
+		return nil, errors.New("the AWS_CA_BUNDLE environment variable cannot be used with client_certificate, configure custom_ca_bundle instead")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(c.ClientCertificate, c.ClientPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS client certificate: %w", err)
+	}
+
+	var rootCAs *x509.CertPool
+	if c.CustomCABundle != "" {
+		pem, err := os.ReadFile(c.CustomCABundle)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle (%s)", c.CustomCABundle)
+		}
+	}
+
+	proxyConfig := httpproxy.FromEnvironment()
+	if c.HTTPProxy != nil {
+		v := aws_sdkv2.ToString(c.HTTPProxy)
+		if _, err := url.Parse(v); err != nil {
+			return nil, fmt.Errorf("parsing HTTP proxy URL: %w", err)
+		}
+		proxyConfig.HTTPProxy = v
+		// Legacy proxy mode: http_proxy also applies to HTTPS requests unless overridden.
+		if proxyConfig.HTTPSProxy == "" {
+			proxyConfig.HTTPSProxy = v
+		}
+	}
+	if c.HTTPSProxy != nil {
+		v := aws_sdkv2.ToString(c.HTTPSProxy)
+		if _, err := url.Parse(v); err != nil {
+			return nil, fmt.Errorf("parsing HTTPS proxy URL: %w", err)
+		}
+		proxyConfig.HTTPSProxy = v
+	}
+	if c.NoProxy != "" {
+		proxyConfig.NoProxy = c.NoProxy
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+
+	buildableClient := awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		tr.MaxIdleConnsPerHost = awshttp.DefaultHTTPTransportMaxIdleConnsPerHost
+
+		if tr.TLSClientConfig == nil {
+			tr.TLSClientConfig = &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			}
+		}
+		tr.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+		if rootCAs != nil {
+			tr.TLSClientConfig.RootCAs = rootCAs
+		}
+		if c.Insecure {
+			tr.TLSClientConfig.InsecureSkipVerify = true
+		}
+
+		tr.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	})
+
+	return &http.Client{
+		Timeout:   buildableClient.GetTimeout(),
+		Transport: buildableClient.GetTransport(),
+	}, nil
+}
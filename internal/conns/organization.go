@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	organizations_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// verifyOrganizationAllowed returns an error if the account is not a member of one of the allowed organizations
+// or is not under one of the allowed organizational unit (OU) paths.
+func verifyOrganizationAllowed(ctx context.Context, conn *organizations_sdkv2.Client, accountID string, allowedOrganizationIDs, allowedOUPaths []string) error {
+	if len(allowedOrganizationIDs) == 0 && len(allowedOUPaths) == 0 {
+		return nil
+	}
+
+	if accountID == "" {
+		return errors.New("AWS account ID is required to verify its organization, skip_requesting_account_id must not be set")
+	}
+
+	output, err := conn.DescribeOrganization(ctx, &organizations_sdkv2.DescribeOrganizationInput{})
+
+	if errs.IsA[*organizationstypes_sdkv2.AWSOrganizationsNotInUseException](err) {
+		return fmt.Errorf("AWS account %s is not a member of an organization", accountID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading AWS Organizations organization: %w", err)
+	}
+
+	if output == nil || output.Organization == nil {
+		return errors.New("reading AWS Organizations organization: empty result")
+	}
+
+	organizationID := aws_sdkv2.ToString(output.Organization.Id)
+
+	if len(allowedOrganizationIDs) > 0 && !slices.Contains(allowedOrganizationIDs, organizationID) {
+		return fmt.Errorf("AWS account %s is a member of organization %s, which is not allowed", accountID, organizationID)
+	}
+
+	if len(allowedOUPaths) > 0 {
+		path, err := accountOUPath(ctx, conn, organizationID, accountID)
+
+		if err != nil {
+			return fmt.Errorf("reading AWS account %s organizational unit path: %w", accountID, err)
+		}
+
+		if !ouPathAllowed(path, allowedOUPaths) {
+			return fmt.Errorf("AWS account %s is under organizational unit path %s, which is not allowed", accountID, path)
+		}
+	}
+
+	return nil
+}
+
+// accountOUPath returns the account's organizational unit path in the form used by the aws:PrincipalOrgPaths
+// condition key, e.g. "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/".
+// Listing an account's parents requires access to the organization's management or a delegated administrator account.
+func accountOUPath(ctx context.Context, conn *organizations_sdkv2.Client, organizationID, accountID string) (string, error) {
+	var ids []string
+
+	for childID := accountID; ; {
+		output, err := conn.ListParents(ctx, &organizations_sdkv2.ListParentsInput{
+			ChildId: aws_sdkv2.String(childID),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		if output == nil || len(output.Parents) == 0 {
+			return "", fmt.Errorf("%s has no parent", childID)
+		}
+
+		parent := output.Parents[0]
+		childID = aws_sdkv2.ToString(parent.Id)
+		ids = append(ids, childID)
+
+		if parent.Type == organizationstypes_sdkv2.ParentTypeRoot {
+			break
+		}
+	}
+
+	slices.Reverse(ids)
+
+	return organizationID + "/" + strings.Join(ids, "/") + "/", nil
+}
+
+// ouPathAllowed returns whether the organizational unit path is equal to or under any of the allowed paths.
+func ouPathAllowed(path string, allowedPaths []string) bool {
+	for _, v := range allowedPaths {
+		if !strings.HasSuffix(v, "/") {
+			v += "/"
+		}
+
+		if strings.HasPrefix(path, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestOUPathAllowed(t *testing.T) {
+	t.Parallel()
+
+	const path = "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"
+
+	testCases := []struct {
+		name         string
+		allowedPaths []string
+		want         bool
+	}{
+		{
+			name:         "organization",
+			allowedPaths: []string{"o-a1b2c3d4e5/"},
+			want:         true,
+		},
+		{
+			name:         "parent OU",
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			want:         true,
+		},
+		{
+			name:         "OU without trailing slash",
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222"},
+			want:         true,
+		},
+		{
+			name:         "OU ID prefix",
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1111"},
+		},
+		{
+			name:         "sibling OU",
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/"},
+		},
+		{
+			name:         "any of",
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/", "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			want:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ouPathAllowed(path, testCase.allowedPaths), testCase.want; got != want {
+				t.Errorf("ouPathAllowed() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// serviceEndpointsIDs maps service package names to AWS SDK for Go v1 endpoints IDs where they differ.
+var serviceEndpointsIDs = map[string]string{
+	names.AccessAnalyzer:               "access-analyzer",
+	names.ACMPCA:                       "acm-pca",
+	names.AMP:                          "aps",
+	names.APIGatewayV2:                 "apigateway",
+	names.AppIntegrations:              "app-integrations",
+	names.AppAutoScaling:               "application-autoscaling",
+	names.ApplicationSignals:           "application-signals",
+	names.AppStream:                    "appstream2",
+	names.AutoScalingPlans:             "autoscaling-plans",
+	names.BCMDataExports:               "bcm-data-exports",
+	names.BedrockAgent:                 "bedrock-agent",
+	names.ChimeSDKMediaPipelines:       "media-pipelines-chime",
+	names.ChimeSDKVoice:                "voice-chime",
+	names.CloudControl:                 "cloudcontrolapi",
+	names.CloudWatch:                   "monitoring",
+	names.CodeGuruProfiler:             "codeguru-profiler",
+	names.CodeGuruReviewer:             "codeguru-reviewer",
+	names.CodeStarConnections:          "codestar-connections",
+	names.CodeStarNotifications:        "codestar-notifications",
+	names.CognitoIdentity:              "cognito-identity",
+	names.CognitoIDP:                   "cognito-idp",
+	names.ComputeOptimizer:             "compute-optimizer",
+	names.ConfigService:                "config",
+	names.ConnectCases:                 "cases",
+	names.CostOptimizationHub:          "cost-optimization-hub",
+	names.CustomerProfiles:             "profile",
+	names.Deploy:                       "codedeploy",
+	names.Detective:                    "api.detective",
+	names.DevOpsGuru:                   "devops-guru",
+	names.DocDB:                        "rds",
+	names.DocDBElastic:                 "docdb-elastic",
+	names.ECR:                          "api.ecr",
+	names.ECRPublic:                    "api.ecr-public",
+	names.EFS:                          "elasticfilesystem",
+	names.Elasticsearch:                "es",
+	names.ELB:                          "elasticloadbalancing",
+	names.ELBV2:                        "elasticloadbalancing",
+	names.EMR:                          "elasticmapreduce",
+	names.EMRContainers:                "emr-containers",
+	names.EMRServerless:                "emr-serverless",
+	names.Keyspaces:                    "cassandra",
+	names.KinesisAnalyticsV2:           "kinesisanalytics",
+	names.LexModels:                    "models.lex",
+	names.LexV2Models:                  "models-v2-lex",
+	names.LicenseManager:               "license-manager",
+	names.Location:                     "geo",
+	names.MemoryDB:                     "memory-db",
+	names.MWAA:                         "airflow",
+	names.Neptune:                      "rds",
+	names.NetworkFirewall:              "network-firewall",
+	names.OpenSearch:                   "es",
+	names.OpenSearchServerless:         "aoss",
+	names.PaymentCryptography:          "controlplane.payment-cryptography",
+	names.PCAConnectorAD:               "pca-connector-ad",
+	names.Pricing:                      "api.pricing",
+	names.RedshiftData:                 "redshift-data",
+	names.RedshiftServerless:           "redshift-serverless",
+	names.ResourceExplorer2:            "resource-explorer-2",
+	names.ResourceGroups:               "resource-groups",
+	names.ResourceGroupsTaggingAPI:     "tagging",
+	names.Route53RecoveryControlConfig: "route53-recovery-control-config",
+	names.Route53RecoveryReadiness:     "route53-recovery-readiness",
+	names.S3Control:                    "s3-control",
+	names.S3Outposts:                   "s3-outposts",
+	names.SageMaker:                    "api.sagemaker",
+	names.ServiceCatalogAppRegistry:    "servicecatalog-appregistry",
+	names.SES:                          "email",
+	names.SESV2:                        "email",
+	names.SFN:                          "states",
+	names.SimpleDB:                     "sdb",
+	names.SSMContacts:                  "ssm-contacts",
+	names.SSMIncidents:                 "ssm-incidents",
+	names.SSMSAP:                       "ssm-sap",
+	names.SSOAdmin:                     "sso",
+	names.TimestreamInfluxDB:           "timestream-influxdb",
+	names.TimestreamWrite:              "ingest.timestream",
+	names.VPCLattice:                   "vpc-lattice",
+	names.WAFRegional:                  "waf-regional",
+	names.WorkSpacesWeb:                "workspaces-web",
+}
+
+// ValidateServiceRegion returns an error if the provider's validate_service_regions argument is set
+// and the AWS SDK endpoint metadata shows that the service is not available in the specified Region.
+// Services with a custom endpoint are not validated.
+func (c *AWSClient) ValidateServiceRegion(_ context.Context, servicePackageName, region string) error {
+	if !c.validateServiceRegions {
+		return nil
+	}
+
+	if c.endpoints[servicePackageName] != "" {
+		return nil
+	}
+
+	return validateServiceRegion(servicePackageName, region)
+}
+
+func validateServiceRegion(servicePackageName, region string) error {
+	partition, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region)
+	if !ok {
+		return fmt.Errorf("unknown AWS Region (%s)", region)
+	}
+
+	id := servicePackageName
+	if v, ok := serviceEndpointsIDs[servicePackageName]; ok {
+		id = v
+	}
+
+	// Services missing from the endpoint metadata are not validated.
+	service, ok := partition.Services()[id]
+	if !ok {
+		return nil
+	}
+
+	regions := service.Regions()
+
+	// Global services, e.g. IAM, have a single partition endpoint and no Regional endpoints.
+	if len(regions) == 0 {
+		return nil
+	}
+
+	if _, ok := regions[region]; !ok {
+		serviceName, err := names.FullHumanFriendly(servicePackageName)
+		if err != nil {
+			serviceName = servicePackageName
+		}
+
+		return fmt.Errorf("%s is not available in AWS Region (%s)", serviceName, region)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateServiceRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		region             string
+		wantErr            bool
+	}{
+		{
+			name:               "regional service",
+			servicePackageName: names.EC2,
+			region:             names.USWest2RegionID,
+		},
+		{
+			name:               "global service",
+			servicePackageName: names.IAM,
+			region:             "af-south-1",
+		},
+		{
+			name:               "service not in Region",
+			servicePackageName: names.Location,
+			region:             "af-south-1",
+			wantErr:            true,
+		},
+		{
+			name:               "unknown service",
+			servicePackageName: "unknown",
+			region:             names.USWest2RegionID,
+		},
+		{
+			name:               "unknown Region",
+			servicePackageName: names.EC2,
+			region:             "xx-fake-1",
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateServiceRegion(testCase.servicePackageName, testCase.region)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("validateServiceRegion() err %t, want %t: %s", got, want, err)
+			}
+		})
+	}
+}
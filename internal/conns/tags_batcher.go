@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	resourcegroupstaggingapi_sdkv2 "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// tagsBatchMaxSize is the maximum number of ARNs in a Resource Groups Tagging API GetResources request.
+	tagsBatchMaxSize = 100
+	// tagsBatchWindow is how long a batch waits for further requests before being sent.
+	tagsBatchWindow = 20 * time.Millisecond
+)
+
+// BatchedResourceTags returns the tags of the resource with the specified ARN.
+// Concurrent calls are coalesced into batched Resource Groups Tagging API requests.
+// ok is false if batched tag reads are not enabled, the identifier is not an ARN in the current Region,
+// the Resource Groups Tagging API did not return the resource (it may be untagged or not supported)
+// or the request failed. The caller should then read the resource's tags from the service API.
+func (c *AWSClient) BatchedResourceTags(ctx context.Context, identifier string) (tftags.KeyValueTags, bool) {
+	if !c.batchTagReads {
+		return nil, false
+	}
+
+	region := c.RegionForContext(ctx)
+	if v, err := arn.Parse(identifier); err != nil || v.Region != region {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	if c.tagsBatcher == nil {
+		c.tagsBatcher = &tagsBatcher{
+			pending: make(map[string]*tagsBatch),
+		}
+	}
+	batcher := c.tagsBatcher
+	c.lock.Unlock()
+
+	tags, found, err := batcher.resourceTags(ctx, region, identifier, func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+		return getResourcesTags(ctx, c.ResourceGroupsTaggingAPIClient(ctx), arns)
+	})
+
+	if err != nil {
+		tflog.Debug(ctx, "batched tag read failed", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	return tftags.New(ctx, tags), true
+}
+
+// tagsBatcher coalesces concurrent requests for resource tags into batches.
+type tagsBatcher struct {
+	lock    sync.Mutex
+	pending map[string]*tagsBatch // Keyed by Region.
+}
+
+// tagsBatch is a single batched request.
+// Its results are available once done is closed.
+type tagsBatch struct {
+	arns []string
+	sent bool
+	done chan struct{}
+	tags map[string]map[string]string
+	err  error
+}
+
+type tagsBatchGetFunc func(context.Context, []string) (map[string]map[string]string, error)
+
+// resourceTags adds the ARN to the pending batch for the Region and waits for the batch's results.
+func (b *tagsBatcher) resourceTags(ctx context.Context, region, arn string, f tagsBatchGetFunc) (map[string]string, bool, error) {
+	b.lock.Lock()
+	batch, ok := b.pending[region]
+	if !ok {
+		batch = &tagsBatch{
+			done: make(chan struct{}),
+		}
+		b.pending[region] = batch
+
+		// The batch outlives the request that started it.
+		batchCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(tagsBatchWindow, func() {
+			b.send(batchCtx, region, batch, f)
+		})
+	}
+	batch.arns = append(batch.arns, arn)
+	if len(batch.arns) == tagsBatchMaxSize {
+		// The batch is full: Later requests start a new batch.
+		delete(b.pending, region)
+		go b.send(context.WithoutCancel(ctx), region, batch, f)
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return nil, false, batch.err
+	}
+
+	tags, ok := batch.tags[arn]
+
+	return tags, ok, nil
+}
+
+// send sends the batch, if it has not already been sent, and makes its results available.
+func (b *tagsBatcher) send(ctx context.Context, region string, batch *tagsBatch, f tagsBatchGetFunc) {
+	b.lock.Lock()
+	if b.pending[region] == batch {
+		delete(b.pending, region)
+	}
+	if batch.sent {
+		b.lock.Unlock()
+		return
+	}
+	batch.sent = true
+	arns := batch.arns
+	b.lock.Unlock()
+
+	batch.tags, batch.err = f(ctx, arns)
+	close(batch.done)
+}
+
+func getResourcesTags(ctx context.Context, conn *resourcegroupstaggingapi_sdkv2.Client, arns []string) (map[string]map[string]string, error) {
+	input := &resourcegroupstaggingapi_sdkv2.GetResourcesInput{
+		ResourceARNList: arns,
+	}
+	output := make(map[string]map[string]string, len(arns))
+
+	pages := resourcegroupstaggingapi_sdkv2.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			tags := make(map[string]string, len(v.Tags))
+			for _, tag := range v.Tags {
+				tags[aws_sdkv2.ToString(tag.Key)] = aws_sdkv2.ToString(tag.Value)
+			}
+			output[aws_sdkv2.ToString(v.ResourceARN)] = tags
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTagsBatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := &tagsBatcher{
+		pending: make(map[string]*tagsBatch),
+	}
+
+	var calls atomic.Int32
+	f := func(_ context.Context, arns []string) (map[string]map[string]string, error) {
+		calls.Add(1)
+
+		if len(arns) > tagsBatchMaxSize {
+			return nil, fmt.Errorf("batch size %d exceeds %d", len(arns), tagsBatchMaxSize)
+		}
+
+		output := make(map[string]map[string]string)
+		for _, arn := range arns {
+			// Untagged resources are not returned.
+			if arn == "untagged" {
+				continue
+			}
+			output[arn] = map[string]string{"Name": arn}
+		}
+
+		return output, nil
+	}
+
+	const n = 150
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			arn := fmt.Sprintf("arn-%d", i)
+			tags, found, err := batcher.resourceTags(ctx, "us-west-2", arn, f) //lintignore:AWSAT003
+
+			switch {
+			case err != nil:
+				errs[i] = err
+			case !found:
+				errs[i] = fmt.Errorf("%s not found", arn)
+			case tags["Name"] != arn:
+				errs[i] = fmt.Errorf("%s: unexpected tags %v", arn, tags)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("batched calls = %d, want %d", got, want)
+	}
+
+	_, found, err := batcher.resourceTags(ctx, "us-west-2", "untagged", f) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Error("untagged resource found")
+	}
+}
+
+func TestTagsBatcherError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := &tagsBatcher{
+		pending: make(map[string]*tagsBatch),
+	}
+
+	f := func(context.Context, []string) (map[string]map[string]string, error) {
+		return nil, errors.New("throttled")
+	}
+
+	if _, _, err := batcher.resourceTags(ctx, "us-west-2", "arn", f); err == nil { //lintignore:AWSAT003
+		t.Error("expected error")
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,6 +30,8 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 }
 
 // SetTagsAll calculates the new value for the `tags_all` attribute.
+// An error diagnostic is also added if the merged tags are missing any required tag keys
+// or do not comply with the provider's tag policy.
 func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	// If the entire plan is null, the resource is planned for destruction.
 	if request.Plan.Raw.IsNull() {
@@ -38,6 +41,11 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
+	// The provider-level configuration in Context takes into account any resource type exclusions.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	var planTags types.Map
 
 	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
@@ -49,7 +57,23 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
-			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+			mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+			if missing := defaultTagsConfig.MissingRequiredKeys(mergedTags); len(missing) > 0 {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Missing required tag keys", strings.Join(missing, ", "))
+				return
+			}
+
+			if violations := defaultTagsConfig.TagPolicyViolations(mergedTags); len(violations) > 0 {
+				if defaultTagsConfig.TagPolicyCompliance == tftags.TagPolicyComplianceWarning {
+					response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tags do not comply with tag policy", strings.Join(violations, "\n"))
+				} else {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tags do not comply with tag policy", strings.Join(violations, "\n"))
+					return
+				}
+			}
+
+			allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
@@ -5,11 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
@@ -34,5 +36,56 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	providerServer := func() tfprotov5.ProviderServer {
+		return &deletionProtectionProviderServer{
+			ProviderServer: muxServer.ProviderServer(),
+			meta:           primary.Meta,
+		}
+	}
+
+	return providerServer, primary, nil
+}
+
+// deletionProtectionProviderServer rejects plans that replace resources whose type is protected by the
+// provider configuration's deletion_protected_resource_types argument.
+// Replacement is detected from the planned change, so it covers both Plugin SDK and Plugin Framework resources
+// and fails before a create_before_destroy replacement object is created.
+type deletionProtectionProviderServer struct {
+	tfprotov5.ProviderServer
+	meta func() any
+}
+
+func (s *deletionProtectionProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || len(response.RequiresReplace) == 0 {
+		return response, err
+	}
+
+	if v, ok := s.meta().(*conns.AWSClient); !ok || !v.IsDeletionProtected(ctx, request.TypeName) {
+		return response, nil
+	}
+
+	// Only a change to an existing resource that is planned to continue to exist can be a replacement.
+	if isNullDynamicValue(request.PriorState) || isNullDynamicValue(response.PlannedState) {
+		return response, nil
+	}
+
+	response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("%s cannot be replaced", request.TypeName),
+		Detail:   "The resource type is listed in the provider deletion_protected_resource_types argument. Replacing the resource would destroy the existing object.",
+	})
+
+	return response, nil
+}
+
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	null, err := v.IsNull()
+
+	return err == nil && null
 }
@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -26,6 +28,7 @@ import (
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -56,10 +59,39 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_organization_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organization IDs. The account must be a member of one of these organizations.",
+			},
+			"allowed_ou_paths": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The account must be under one of these paths.",
+			},
+			"batch_tag_reads": schema.BoolAttribute{
+				Optional: true,
+				Description: "Read resource tags during refresh in batches using the Resource Groups Tagging API " +
+					"instead of one service API call per resource.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "File containing a PEM-encoded TLS client certificate to present for mutual TLS.",
+			},
+			"client_private_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "File containing the PEM-encoded private key for `client_certificate`.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"deletion_protected_resource_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource types, e.g. `aws_db_instance`, that the provider refuses to destroy. " +
+					"This also prevents replacement. Independent of any per-resource deletion protection arguments.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -80,10 +112,22 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_propagation_timeout": schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "The maximum amount of time to wait for IAM changes to propagate before read-after-write operations fail. Valid time units are ns, us (or µs), ms, s, h, or m. If omitted, default value is `2m`",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"list_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The maximum number of concurrent AWS API requests made by data sources that list many resources. If omitted, default value is `4`",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -118,6 +162,12 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Per-service overrides of `max_retries`, keyed by service name as used in the `endpoints` block, " +
+					"e.g. `ec2` or `iam`.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -164,6 +214,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_service_regions": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate at plan time that each service is available in the AWS Region of the resources being created.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -259,6 +313,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types to which the default tags configuration does not apply.",
+						},
+						"required_tag_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tag keys that every tagged resource must have.",
+						},
+						"tag_policy_compliance": schema.StringAttribute{
+							Optional: true,
+							Description: "Action to take when resource tags do not comply with the effective AWS Organizations tag policy. " +
+								"Valid values are `disabled`, `warning` and `error`. Defaults to `disabled`.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -299,6 +368,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -335,7 +405,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -406,13 +476,16 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			// The deletion protection interceptor must run before all other interceptors.
+			interceptors := resourceInterceptors{
+				deletionProtectionResourceInterceptor{typeName: typeName},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -457,6 +530,57 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -480,6 +604,17 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Per-service overrides of the provider `use_dualstack_endpoint` argument, keyed by service name",
+	}
+	endpointsAttributes["use_fips_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Per-service overrides of the provider `use_fips_endpoint` argument, keyed by service name",
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
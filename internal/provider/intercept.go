@@ -5,7 +5,9 @@ package provider
 
 import (
 	"context"
+	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -179,6 +181,25 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	}
 }
 
+// RegionState wraps an import handler so that an import ID of the form "<id>@<region>"
+// imports the resource from the specified Region.
+func (r *wrappedResource) RegionState(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region := regionImportID(d.Id()); region != "" {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
@@ -324,7 +345,10 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
-						if v, ok := sp.(interface {
+						// On refresh, try a batched read first.
+						if tags, ok := batchedResourceTags(ctx, why, meta, identifier); ok {
+							tagsInContext.TagsOut = option.Some(tags)
+						} else if v, ok := sp.(interface {
 							ListTags(context.Context, any, string) error
 						}); ok {
 							err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -384,6 +408,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	return ctx, diags
 }
 
+// batchedResourceTags returns the resource's tags from a batched read.
+// Batched reads are only used on refresh as the Resource Groups Tagging API is eventually consistent.
+func batchedResourceTags(ctx context.Context, why why, meta any, identifier string) (tftags.KeyValueTags, bool) {
+	if why != Read {
+		return nil, false
+	}
+
+	return meta.(*conns.AWSClient).BatchedResourceTags(ctx, identifier)
+}
+
 // tagsResourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -497,3 +531,107 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// deletionProtectionInterceptor prevents the destruction of resources whose type is protected by the provider configuration.
+type deletionProtectionInterceptor struct {
+	typeName string
+}
+
+func (r deletionProtectionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || why != Delete {
+		return ctx, diags
+	}
+
+	if v, ok := meta.(*conns.AWSClient); ok && v.IsDeletionProtected(ctx, r.typeName) {
+		return ctx, sdkdiag.AppendErrorf(diags, "%s (%s) cannot be destroyed: resource type is listed in the provider deletion_protected_resource_types argument", r.typeName, d.Id())
+	}
+
+	return ctx, diags
+}
+
+// regionInterceptor implements per-resource Region override for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Use the configured (or, for Delete, the last known) Region for all API calls.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.OverrideRegion = v
+		}
+	case After:
+		if why == Delete {
+			break
+		}
+
+		if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).RegionForContext(ctx)); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans the provider's Region for resources that do not configure the "region" argument.
+// Removing the argument from configuration therefore moves the resource back to the provider's Region.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if config := d.GetRawConfig(); config.IsNull() || !config.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	// Resources created before they supported Region override have no Region in state until refreshed.
+	old := d.Get(names.AttrRegion).(string)
+	if d.Id() != "" && old == "" {
+		return nil
+	}
+
+	if region := meta.(*conns.AWSClient).Region; old != region {
+		return d.SetNew(names.AttrRegion, region)
+	}
+
+	return nil
+}
+
+// serviceRegionCustomizeDiff returns a CustomizeDiff function that checks, when a resource is being created,
+// that its service is available in the resource's AWS Region.
+func serviceRegionCustomizeDiff(regionOverrideEnabled bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if d.Id() != "" {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		c := meta.(*conns.AWSClient)
+		region := c.Region
+		if regionOverrideEnabled {
+			if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" && d.NewValueKnown(names.AttrRegion) {
+				region = v
+			}
+		}
+
+		return c.ValidateServiceRegion(ctx, inContext.ServicePackageName, region)
+	}
+}
+
+// regionImportID splits an import ID of the form "<id>@<region>" into its parts.
+// The ID is returned unchanged if it does not end with an AWS Region.
+func regionImportID(id string) (string, string) {
+	if i := strings.LastIndex(id, "@"); i > 0 {
+		if region := id[i+1:]; regionRegexp.MatchString(region) {
+			return id[:i], region
+		}
+	}
+
+	return id, ""
+}
+
+var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
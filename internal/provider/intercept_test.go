@@ -93,3 +93,53 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestRegionImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id         string
+		wantID     string
+		wantRegion string
+	}{
+		{
+			id:     "queue",
+			wantID: "queue",
+		},
+		{
+			id:         "queue@us-west-2",
+			wantID:     "queue",
+			wantRegion: "us-west-2",
+		},
+		{
+			id:         "a@b@us-gov-west-1",
+			wantID:     "a@b",
+			wantRegion: "us-gov-west-1",
+		},
+		{
+			id:     "user@example.com",
+			wantID: "user@example.com",
+		},
+		{
+			id:     "@us-west-2",
+			wantID: "@us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			id, region := regionImportID(testCase.id)
+
+			if got, want := id, testCase.wantID; got != want {
+				t.Errorf("id = %v, want %v", got, want)
+			}
+			if got, want := region, testCase.wantRegion; got != want {
+				t.Errorf("region = %v, want %v", got, want)
+			}
+		})
+	}
+}
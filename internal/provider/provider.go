@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -49,8 +51,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organization IDs. The account must be a member of one of these organizations.",
+			},
+			"allowed_ou_paths": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The account must be under one of these paths.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tag_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Read resource tags during refresh in batches using the Resource Groups Tagging API " +
+					"instead of one service API call per resource.",
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_private_key"},
+				Description:  "File containing a PEM-encoded TLS client certificate to present for mutual TLS.",
+			},
+			"client_private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "File containing the PEM-encoded private key for `client_certificate`.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +97,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which the default tags configuration does not apply.",
+						},
+						"required_tag_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys that every tagged resource must have.",
+						},
+						"tag_policy_compliance": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Action to take when resource tags do not comply with the effective AWS Organizations tag policy. " +
+								"Valid values are `disabled`, `warning` and `error`. Defaults to `disabled`.",
+							ValidateFunc: validation.StringInSlice(tftags.TagPolicyComplianceValues(), false),
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +125,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"deletion_protected_resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^aws_[0-9a-z_]+$`), "must be a resource type name, e.g. aws_db_instance"),
+				},
+				Description: "Resource types, e.g. `aws_db_instance`, that the provider refuses to destroy. " +
+					"Plans that replace such resources are rejected. Independent of any per-resource deletion protection arguments.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -127,12 +188,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"iam_propagation_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				Description: "The maximum amount of time to wait for IAM changes to propagate " +
+					"before read-after-write operations fail. Valid time units are ns, us (or µs), ms, s, h, or m. " +
+					"If omitted, default value is `2m`",
+			},
 			"insecure": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"list_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of concurrent AWS API requests made by data sources that list many resources. If omitted, default value is `4`",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -185,6 +260,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Per-service overrides of `max_retries`, keyed by service name as used in the `endpoints` block, " +
+					"e.g. `ec2` or `iam`.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -249,6 +331,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_service_regions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Validate at plan time that each service is available in the AWS Region of the resources being created.",
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -290,7 +377,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -298,6 +385,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The data source has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				addSchemaAttribute(r, names.AttrRegion, &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region"),
+					Description:  "AWS Region in which the data source is read. Defaults to the provider Region.",
+				})
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -367,13 +477,46 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			// The deletion protection interceptor must run before all other interceptors.
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         Delete,
+					interceptor: deletionProtectionInterceptor{typeName: typeName},
+				},
+			}
+			regionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+
+			if regionOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				addSchemaAttribute(r, names.AttrRegion, &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region"),
+					Description:  "AWS Region in which the resource is managed. Defaults to the provider Region.",
+				})
+
+				// The Region interceptor must run before any interceptors that make API calls.
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -429,9 +572,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if regionOverrideEnabled {
+						v = rs.RegionState(v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if regionOverrideEnabled {
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+			}
+			if v := r.CustomizeDiff; v != nil {
+				r.CustomizeDiff = customdiff.Sequence(serviceRegionCustomizeDiff(regionOverrideEnabled), v)
+			} else {
+				r.CustomizeDiff = serviceRegionCustomizeDiff(regionOverrideEnabled)
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -477,6 +635,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BatchTagReads:                  d.Get("batch_tag_reads").(bool),
+		ClientCertificate:              d.Get("client_certificate").(string),
+		ClientPrivateKey:               d.Get("client_private_key").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -496,6 +657,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		ValidateServiceRegions:         d.Get("validate_service_regions").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -514,6 +676,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_ou_paths"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOUPaths = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role configuration set", map[string]any{
@@ -536,6 +706,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deletion_protected_resource_types"); ok && v.(*schema.Set).Len() > 0 {
+		config.DeletionProtectedResourceTypes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -544,6 +718,29 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	for _, tfMapRaw := range v.(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["use_dualstack_endpoint"].(map[string]interface{}); ok && len(v) > 0 {
+			serviceUseDualStackEndpoint, err := expandServiceMap[bool]("endpoints.use_dualstack_endpoint", v)
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+			config.ServiceUseDualStackEndpoint = serviceUseDualStackEndpoint
+		}
+
+		if v, ok := tfMap["use_fips_endpoint"].(map[string]interface{}); ok && len(v) > 0 {
+			serviceUseFIPSEndpoint, err := expandServiceMap[bool]("endpoints.use_fips_endpoint", v)
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+			config.ServiceUseFIPSEndpoint = serviceUseFIPSEndpoint
+		}
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -567,10 +764,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.Get("iam_propagation_timeout").(string); ok && v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "parsing iam_propagation_timeout (%s): %s", v, err)
+		}
+		config.IAMPropagationTimeout = duration
+	}
+
+	if v, ok := d.GetOk("list_concurrency"); ok {
+		config.ListConcurrency = v.(int)
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_max_retries"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceMaxRetries, err := expandServiceMaxRetries(v.(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceMaxRetries = serviceMaxRetries
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -743,6 +960,19 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Per-service overrides of the provider `use_dualstack_endpoint` argument, keyed by service name",
+	}
+	endpointsAttributes["use_fips_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Per-service overrides of the provider `use_fips_endpoint` argument, keyed by service name",
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -838,6 +1068,23 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+// addSchemaAttribute adds the specified attribute to a resource's or data source's schema.
+func addSchemaAttribute(r *schema.Resource, name string, attribute *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[name] = attribute
+			return m
+		}
+		return
+	}
+
+	if r.Schema == nil {
+		r.Schema = make(map[string]*schema.Schema)
+	}
+	r.Schema[name] = attribute
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -849,9 +1096,46 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["required_tag_keys"].(*schema.Set); ok {
+		defaultConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(defaultConfig.RequiredKeys)
+	}
+
+	if v, ok := tfMap["tag_policy_compliance"].(string); ok && v != "" {
+		defaultConfig.TagPolicyCompliance = v
+	}
+
 	return defaultConfig
 }
 
+func expandServiceMaxRetries(tfMap map[string]interface{}) (map[string]int, error) {
+	return expandServiceMap[int]("service_max_retries", tfMap)
+}
+
+// expandServiceMap returns the specified map keyed by service package name.
+// The map's keys may be service package names or any of their aliases.
+func expandServiceMap[T any](attr string, tfMap map[string]interface{}) (map[string]T, error) {
+	apiObject := make(map[string]T, len(tfMap))
+
+	for k, v := range tfMap {
+		pkg := k
+		if !slices.Contains(names.ProviderPackages(), pkg) {
+			var err error
+			if pkg, err = names.ProviderPackageForAlias(k); err != nil {
+				return nil, fmt.Errorf("%s: unsupported service %q", attr, k)
+			}
+		}
+
+		apiObject[pkg] = v.(T)
+	}
+
+	return apiObject, nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -10,7 +10,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -132,6 +134,122 @@ func TestAccProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	})
 }
 
+func TestAccProvider_DefaultTags_excludeResourceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vpcResourceName := "aws_vpc.test"
+	subnetResourceName := "aws_subnet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_defaultTagsExcludeResourceTypes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vpcResourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(vpcResourceName, "tags_all.Name", rName),
+					resource.TestCheckResourceAttr(subnetResourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(subnetResourceName, "tags_all.Name", rName),
+					resource.TestCheckResourceAttr(subnetResourceName, "tags_all.Owner", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_DefaultTags_requiredTagKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_defaultTagsRequiredTagKeys(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`missing required tag keys: CostCenter, Owner`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_DefaultTags_tagPolicyComplianceInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_defaultTagsTagPolicyCompliance("invalid"),
+				ExpectError: regexache.MustCompile(`expected default_tags.0.tag_policy_compliance to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_deletionProtectedResourceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_deletionProtectedResourceTypes(rName, `["aws_ssm_parameter"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				// Renaming the parameter forces replacement, which is rejected at plan time.
+				Config:      testAccProviderConfig_deletionProtectedResourceTypes(rName+"-new", `["aws_ssm_parameter"]`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`aws_ssm_parameter cannot be replaced`),
+			},
+			{
+				Config:      testAccProviderConfig_deletionProtectedResourceTypes(rName, `["aws_ssm_parameter"]`),
+				Destroy:     true,
+				ExpectError: regexache.MustCompile(`aws_ssm_parameter \(.+\) cannot be destroyed`),
+			},
+			{
+				// Remove the protection so that the resource can be destroyed.
+				Config: testAccProviderConfig_deletionProtectedResourceTypes(rName, `[]`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_deletionProtectedResourceTypesInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_deletionProtectedResourceTypes(rName, `["ssm_parameter"]`),
+				ExpectError: regexache.MustCompile(`must be a resource type name`),
+			},
+		},
+	})
+}
+
 func TestAccProvider_endpoints(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -394,6 +512,46 @@ func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_iamPropagationTimeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_iamPropagationTimeout("5m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIAMPropagationTimeout(ctx, t, &provider, 5*time.Minute),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccProvider_iamPropagationTimeoutInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_iamPropagationTimeout("5 minutes"),
+				ExpectError: regexache.MustCompile(`"iam_propagation_timeout" cannot be parsed as a duration`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 func TestAccProvider_Region_c2s(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -598,6 +756,20 @@ func testAccCheckDNSSuffix(ctx context.Context, t *testing.T, p **schema.Provide
 	}
 }
 
+func testAccCheckIAMPropagationTimeout(ctx context.Context, t *testing.T, p **schema.Provider, expected time.Duration) resource.TestCheckFunc { //nolint:unparam
+	return func(s *terraform.State) error {
+		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
+			return fmt.Errorf("provider not initialized")
+		}
+
+		if got := (*p).Meta().(*conns.AWSClient).IAMPropagationTimeout(ctx); got != expected {
+			return fmt.Errorf("expected IAM propagation timeout (%s), got: %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRegion(ctx context.Context, t *testing.T, p **schema.Provider, expectedRegion string) resource.TestCheckFunc { //nolint:unparam
 	return func(s *terraform.State) error {
 		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
@@ -1172,6 +1344,88 @@ provider "aws" {
 `)
 }
 
+func testAccProviderConfig_defaultTagsExcludeResourceTypes(rName string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    exclude_resource_types = ["aws_vpc"]
+
+    tags = {
+      Owner = "test"
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccProviderConfig_deletionProtectedResourceTypes(rName, resourceTypes string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  deletion_protected_resource_types = %[2]s
+}
+
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test"
+}
+`, rName, resourceTypes)
+}
+
+func testAccProviderConfig_defaultTagsRequiredTagKeys(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    required_tag_keys = ["Owner", "CostCenter"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccProviderConfig_defaultTagsTagPolicyCompliance(compliance string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tag_policy_compliance = %[1]q
+  }
+
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, compliance))
+}
+
 func testAccProviderConfig_defaultAndIgnoreTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, `
@@ -1199,6 +1453,18 @@ provider "aws" {
 `)
 }
 
+func testAccProviderConfig_iamPropagationTimeout(timeout string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  iam_propagation_timeout     = %[1]q
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, timeout))
+}
+
 func testAccProviderConfig_region(region string) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
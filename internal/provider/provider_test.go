@@ -59,6 +59,59 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap   map[string]interface{}
+		want    map[string]int
+		wantErr bool
+	}{
+		"service names": {
+			tfMap: map[string]interface{}{
+				names.EC2: 50,
+				names.IAM: 10,
+			},
+			want: map[string]int{
+				names.EC2: 50,
+				names.IAM: 10,
+			},
+		},
+		"alias": {
+			tfMap: map[string]interface{}{
+				"cloudwatchlogs": 5,
+			},
+			want: map[string]int{
+				names.Logs: 5,
+			},
+		},
+		"unsupported service": {
+			tfMap: map[string]interface{}{
+				"notaservice": 5,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandServiceMaxRetries(testCase.tfMap)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("expandServiceMaxRetries() err %t, want %t: %s", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SkipDestroySchema returns the schema for the skip_destroy argument.
+// When skip_destroy is true the resource is only removed from state on destroy and the remote object is retained.
+func SkipDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// SkipDestroyDeleteFunc wraps a resource's delete function so that, when skip_destroy is true,
+// the resource is removed from state without deleting the remote object.
+// resourceName is the human friendly name of the resource type, e.g. "EBS Volume", used in log messages.
+func SkipDestroyDeleteFunc(resourceName string, f schema.DeleteContextFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if d.Get(names.AttrSkipDestroy).(bool) {
+			log.Printf("[DEBUG] Retaining %s: %s", resourceName, d.Id())
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// SetSkipDestroy keeps skip_destroy's configured value in state on read.
+// The argument has no remote equivalent and defaults to false on import.
+func SetSkipDestroy(d *schema.ResourceData) {
+	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSkipDestroyDeleteFunc(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		skipDestroy bool
+		wantDeleted bool
+	}{
+		"skip_destroy false": {
+			wantDeleted: true,
+		},
+		"skip_destroy true": {
+			skipDestroy: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				names.AttrSkipDestroy: SkipDestroySchema(),
+			}, map[string]any{
+				names.AttrSkipDestroy: testCase.skipDestroy,
+			})
+			d.SetId("test")
+
+			var deleted bool
+			f := SkipDestroyDeleteFunc("Test Resource", func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				deleted = true
+				return nil
+			})
+
+			if diags := f(ctx, d, nil); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := deleted, testCase.wantDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package apigateway
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strconv"
@@ -17,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -31,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_api_gateway_rest_api", name="REST API")
@@ -71,6 +75,11 @@ func resourceRestAPI() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"body_operations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,7 +183,10 @@ func resourceRestAPI() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			restAPIBodyOperationsDiff,
+		),
 	}
 }
 
@@ -259,6 +271,10 @@ func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "creating API Gateway REST API (%s) specification: %s", d.Id(), err)
 		}
 
+		for _, v := range api.Warnings {
+			diags = sdkdiag.AppendWarningf(diags, "API Gateway REST API (%s) specification import: %s", d.Id(), v)
+		}
+
 		// Using PutRestApi with mode overwrite will remove any configuration
 		// that was done with CreateRestApi. Reconcile these changes by having
 		// any Terraform configured values overwrite imported configuration.
@@ -298,6 +314,12 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("api_key_source", api.ApiKeySource)
 	d.Set(names.AttrARN, apiARN(meta.(*conns.AWSClient), d.Id()))
 	d.Set("binary_media_types", api.BinaryMediaTypes)
+	// body isn't returned by the API, so body_operations is derived from the configured body and is empty after import.
+	if operations, err := openAPIOperations(d.Get("body").(string)); err == nil {
+		d.Set("body_operations", operations)
+	} else {
+		d.Set("body_operations", nil)
+	}
 	d.Set(names.AttrCreatedDate, api.CreatedDate.Format(time.RFC3339))
 	d.Set(names.AttrDescription, api.Description)
 	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
@@ -527,6 +549,10 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 					return sdkdiag.AppendErrorf(diags, "updating API Gateway REST API (%s) specification: %s", d.Id(), err)
 				}
 
+				for _, v := range output.Warnings {
+					diags = sdkdiag.AppendWarningf(diags, "API Gateway REST API (%s) specification import: %s", d.Id(), v)
+				}
+
 				// Using PutRestApi with mode overwrite will remove any configuration
 				// that was done previously. Reconcile these changes by having
 				// any Terraform configured values overwrite imported configuration.
@@ -719,6 +745,78 @@ func escapeJSONPointer(path string) string {
 	return path
 }
 
+// restAPIBodyOperationsDiff plans body_operations from the new body, so that changes to an
+// OpenAPI specification are shown per operation rather than as a single string diff.
+func restAPIBodyOperationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("body") {
+		return d.SetNewComputed("body_operations")
+	}
+
+	if !d.HasChange("body") {
+		return nil
+	}
+
+	operations, err := openAPIOperations(d.Get("body").(string))
+
+	// Malformed specifications are reported by the API at apply time.
+	if err != nil {
+		return d.SetNewComputed("body_operations")
+	}
+
+	return d.SetNew("body_operations", operations)
+}
+
+// openAPIMethods are the path item fields that define operations.
+var openAPIMethods = []string{
+	"delete",
+	"get",
+	"head",
+	"options",
+	"patch",
+	"post",
+	"put",
+	"trace",
+	"x-amazon-apigateway-any-method",
+}
+
+// openAPIOperations returns a map of "METHOD /path" to a digest of the operation's definition
+// for each operation in an OpenAPI or Swagger specification in JSON or YAML format.
+func openAPIOperations(body string) (map[string]string, error) {
+	var spec struct {
+		Paths map[string]map[string]interface{} `yaml:"paths"`
+	}
+
+	if err := yaml.Unmarshal([]byte(body), &spec); err != nil {
+		return nil, err
+	}
+
+	operations := make(map[string]string)
+
+	for path, item := range spec.Paths {
+		for _, method := range openAPIMethods {
+			operation, ok := item[method]
+			if !ok {
+				continue
+			}
+
+			// yaml.Marshal sorts map keys, so equivalent definitions have the same digest.
+			b, err := yaml.Marshal(operation)
+			if err != nil {
+				return nil, err
+			}
+
+			hash := sha256.Sum256(b)
+			key := strings.ToUpper(method)
+			if method == "x-amazon-apigateway-any-method" {
+				key = "ANY"
+			}
+			operations[fmt.Sprintf("%s %s", key, path)] = hex.EncodeToString(hash[:8])
+		}
+	}
+
+	return operations, nil
+}
+
 func modeConfigOrDefault(d *schema.ResourceData) string {
 	if v, ok := d.GetOk("put_rest_api_mode"); ok {
 		return v.(string)
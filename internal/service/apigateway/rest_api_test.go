@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -23,6 +24,66 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestOpenAPIOperations(t *testing.T) {
+	t.Parallel()
+
+	jsonBody := `{"swagger":"2.0","paths":{"/pets":{"get":{"responses":{"200":{"description":"OK"}}},"post":{"responses":{"201":{"description":"Created"}}},"parameters":[]},"/proxy":{"x-amazon-apigateway-any-method":{}}}}`
+	yamlBody := `
+swagger: "2.0"
+paths:
+  /pets:
+    parameters: []
+    get:
+      responses:
+        "200":
+          description: OK
+    post:
+      responses:
+        "201":
+          description: Created
+  /proxy:
+    x-amazon-apigateway-any-method: {}
+`
+	changedBody := `{"swagger":"2.0","paths":{"/pets":{"get":{"responses":{"200":{"description":"Changed"}}}}}}`
+
+	jsonOperations, err := tfapigateway.OpenAPIOperations(jsonBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(jsonOperations), 3; got != want {
+		t.Fatalf("operations = %v, want %d entries", jsonOperations, want)
+	}
+
+	for _, key := range []string{"GET /pets", "POST /pets", "ANY /proxy"} {
+		if _, ok := jsonOperations[key]; !ok {
+			t.Errorf("operations = %v, missing %q", jsonOperations, key)
+		}
+	}
+
+	yamlOperations, err := tfapigateway.OpenAPIOperations(yamlBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(jsonOperations, yamlOperations); diff != "" {
+		t.Errorf("JSON and YAML operations differ (-json +yaml):\n%s", diff)
+	}
+
+	changedOperations, err := tfapigateway.OpenAPIOperations(changedBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changedOperations["GET /pets"] == jsonOperations["GET /pets"] {
+		t.Errorf("digest of changed operation GET /pets did not change")
+	}
+
+	if _, err := tfapigateway.OpenAPIOperations("paths: ["); err == nil {
+		t.Errorf("expected error for malformed body")
+	}
+}
+
 func TestAccAPIGatewayRestAPI_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -284,7 +345,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated API key source still overrides
 			{
@@ -329,7 +390,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -359,7 +420,7 @@ func TestAccAPIGatewayRestAPI_binaryMediaTypes(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_binaryMediaTypes1(rName, "application/octet"),
@@ -397,7 +458,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -445,7 +506,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -469,6 +530,8 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
+					resource.TestCheckResourceAttr(resourceName, "body_operations.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "body_operations.GET /test"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedDate),
@@ -479,13 +542,15 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_body(rName, "/update"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "body_operations.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "body_operations.GET /update"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedDate),
 					resource.TestCheckResourceAttrSet(resourceName, "execution_arn"),
@@ -518,7 +583,7 @@ func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_description(rName, "description2"),
@@ -554,7 +619,7 @@ func TestAccAPIGatewayRestAPI_Description_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated description still overrides
 			{
@@ -599,7 +664,7 @@ func TestAccAPIGatewayRestAPI_Description_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -667,7 +732,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_overrideBody(t *testing.
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify override can be unset (only for body set to false)
 			{
@@ -712,7 +777,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_setByBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -747,7 +812,7 @@ func TestAccAPIGatewayRestAPI_Endpoint_vpcEndpointIDs(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_endpointConfigurationVPCEndpointIds2(rName),
@@ -805,7 +870,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated configuration value still overrides
 			{
@@ -862,7 +927,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_mergeBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 
 			// Verify updated endpoint configuration, and endpoint from OAS is discarded.
@@ -919,7 +984,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 
 			// Add the new attribute and verify works as desired.
@@ -937,7 +1002,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -969,7 +1034,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -998,7 +1063,7 @@ func TestAccAPIGatewayRestAPI_minimumCompressionSize(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_minimumCompressionSize(rName, "-1"), // -1 removes existing values
@@ -1041,7 +1106,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -1086,7 +1151,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -1116,7 +1181,7 @@ func TestAccAPIGatewayRestAPI_Name_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify updated name still overrides
 			{
@@ -1168,7 +1233,7 @@ func TestAccAPIGatewayRestAPI_FailOnWarnings(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 			// Verify invalid body fails update, when fail_on_warnings is true
 			{
@@ -1207,7 +1272,7 @@ func TestAccAPIGatewayRestAPI_parameters(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", names.AttrParameters, "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", names.AttrParameters, "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_parameters1(rName, "basepath", "ignore"),
@@ -1305,7 +1370,7 @@ func TestAccAPIGatewayRestAPI_Policy_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", names.AttrPolicy, "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", names.AttrPolicy, "put_rest_api_mode"},
 			},
 			// Verify updated body still has override policy
 			{
@@ -1352,7 +1417,7 @@ func TestAccAPIGatewayRestAPI_Policy_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_operations", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -53,6 +53,11 @@ func resourceDeployment() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			names.AttrTriggers: {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -76,6 +81,10 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("stage_name"); ok {
+		input.StageName = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateDeployment(ctx, input)
 
 	if err != nil {
@@ -88,6 +97,14 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Deployment (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("stage_name"); ok {
+		stageName := v.(string)
+
+		if err := waitDeploymentStagePropagated(ctx, conn, apiID, stageName, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Deployment (%s) to propagate to Stage (%s): %s", d.Id(), stageName, err)
+		}
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -240,3 +257,21 @@ func waitDeploymentDeployed(ctx context.Context, conn *apigatewayv2.Client, apiI
 
 	return nil, err
 }
+
+// waitDeploymentStagePropagated waits until the specified stage serves the deployment.
+func waitDeploymentStagePropagated(ctx context.Context, conn *apigatewayv2.Client, apiID, stageName, deploymentID string) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	_, err := tfresource.RetryUntilEqual(ctx, timeout, deploymentID, func() (string, error) {
+		output, err := findStageByTwoPartKey(ctx, conn, apiID, stageName)
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(output.DeploymentId), nil
+	})
+
+	return err
+}
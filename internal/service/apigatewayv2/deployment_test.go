@@ -135,6 +135,38 @@ func TestAccAPIGatewayV2Deployment_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2Deployment_stageName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigatewayv2.GetDeploymentOutput
+	resourceName := "aws_apigatewayv2_deployment.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_stageName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					testAccCheckDeploymentStage(ctx, resourceName, stageResourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "stage_name", stageResourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccDeploymentImportStateIdFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stage_name"},
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Client(ctx)
@@ -182,6 +214,34 @@ func testAccCheckDeploymentExists(ctx context.Context, n string, v *apigatewayv2
 	}
 }
 
+func testAccCheckDeploymentStage(ctx context.Context, deploymentResourceName, stageResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		deployment, ok := s.RootModule().Resources[deploymentResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", deploymentResourceName)
+		}
+
+		stage, ok := s.RootModule().Resources[stageResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", stageResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+		output, err := tfapigatewayv2.FindStageByTwoPartKey(ctx, conn, stage.Primary.Attributes["api_id"], stage.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.DeploymentId), deployment.Primary.ID; got != want {
+			return fmt.Errorf("API Gateway v2 Stage (%s) deployment = %s, want %s", stage.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDeploymentNotRecreated(i, j *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if !aws.ToTime(i.CreatedDate).Equal(aws.ToTime(j.CreatedDate)) {
@@ -224,6 +284,26 @@ resource "aws_apigatewayv2_deployment" "test" {
 `, description))
 }
 
+func testAccDeploymentConfig_stageName(rName string) string {
+	return acctest.ConfigCompose(testAccRouteConfig_target(rName), fmt.Sprintf(`
+resource "aws_apigatewayv2_stage" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+  name   = %[1]q
+
+  lifecycle {
+    ignore_changes = [deployment_id]
+  }
+}
+
+resource "aws_apigatewayv2_deployment" "test" {
+  api_id     = aws_apigatewayv2_api.test.id
+  stage_name = aws_apigatewayv2_stage.test.name
+
+  depends_on = [aws_apigatewayv2_route.test]
+}
+`, rName))
+}
+
 func testAccDeploymentConfig_triggers(rName string, apiKeyRequired bool) string {
 	return fmt.Sprintf(`
 resource "aws_apigatewayv2_api" "test" {
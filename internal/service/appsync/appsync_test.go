@@ -74,6 +74,7 @@ func TestAccAppSync_serial(t *testing.T) {
 		"Function": {
 			acctest.CtBasic:           testAccFunction_basic,
 			"code":                    testAccFunction_code,
+			"codeValidation":          testAccFunction_codeValidation,
 			acctest.CtDisappears:      testAccFunction_disappears,
 			"description":             testAccFunction_description,
 			"responseMappingTemplate": testAccFunction_responseMappingTemplate,
@@ -109,6 +110,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDomainNameAPIAssociation_basic,
 			acctest.CtDisappears: testAccDomainNameAPIAssociation_disappears,
 		},
+		"SourceAPIAssociation": {
+			acctest.CtBasic:      testAccSourceAPIAssociation_basic,
+			acctest.CtDisappears: testAccSourceAPIAssociation_disappears,
+			"mergeTriggers":      testAccSourceAPIAssociation_mergeTriggers,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -13,16 +13,18 @@ var (
 	ResourceFunction                 = resourceFunction
 	ResourceGraphQLAPI               = resourceGraphQLAPI
 	ResourceResolver                 = resourceResolver
+	ResourceSourceAPIAssociation     = resourceSourceAPIAssociation
 	ResourceType                     = resourceType
 
-	DefaultAuthorizerResultTTLInSeconds = defaultAuthorizerResultTTLInSeconds
-	FindAPICacheByID                    = findAPICacheByID
-	FindAPIKeyByTwoPartKey              = findAPIKeyByTwoPartKey
-	FindDataSourceByTwoPartKey          = findDataSourceByTwoPartKey
-	FindDomainNameAPIAssociationByID    = findDomainNameAPIAssociationByID
-	FindDomainNameByID                  = findDomainNameByID
-	FindFunctionByTwoPartKey            = findFunctionByTwoPartKey
-	FindGraphQLAPIByID                  = findGraphQLAPIByID
-	FindResolverByThreePartKey          = findResolverByThreePartKey
-	FindTypeByThreePartKey              = findTypeByThreePartKey
+	DefaultAuthorizerResultTTLInSeconds  = defaultAuthorizerResultTTLInSeconds
+	FindAPICacheByID                     = findAPICacheByID
+	FindAPIKeyByTwoPartKey               = findAPIKeyByTwoPartKey
+	FindDataSourceByTwoPartKey           = findDataSourceByTwoPartKey
+	FindDomainNameAPIAssociationByID     = findDomainNameAPIAssociationByID
+	FindDomainNameByID                   = findDomainNameByID
+	FindFunctionByTwoPartKey             = findFunctionByTwoPartKey
+	FindGraphQLAPIByID                   = findGraphQLAPIByID
+	FindResolverByThreePartKey           = findResolverByThreePartKey
+	FindSourceAPIAssociationByTwoPartKey = findSourceAPIAssociationByTwoPartKey
+	FindTypeByThreePartKey               = findTypeByThreePartKey
 )
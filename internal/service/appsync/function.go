@@ -42,6 +42,8 @@ func resourceFunction() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateCode,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -372,6 +374,64 @@ func findFunctionByTwoPartKey(ctx context.Context, conn *appsync.Client, apiID,
 	return output.FunctionConfiguration, nil
 }
 
+// evaluateCodeContext is the stub request context passed to EvaluateCode.
+// Only syntax and runtime restrictions are checked, so the values themselves don't matter.
+const evaluateCodeContext = `{"arguments":{},"source":{},"identity":{},"stash":{},"prev":{"result":{}}}`
+
+// validateCode checks APPSYNC_JS code against the AppSync runtime at plan time.
+// The code must already be bundled into a single file; the provider does not run a bundler.
+func validateCode(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("code") || !d.NewValueKnown("runtime") {
+		return nil
+	}
+
+	if !d.HasChanges("code", "runtime") {
+		return nil
+	}
+
+	code := d.Get("code").(string)
+	runtime := expandRuntime(d.Get("runtime").([]interface{}))
+
+	if code == "" || runtime == nil || runtime.Name != awstypes.RuntimeNameAppsyncJs {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	input := &appsync.EvaluateCodeInput{
+		Code:     aws.String(code),
+		Context:  aws.String(evaluateCodeContext),
+		Function: aws.String("request"),
+		Runtime:  runtime,
+	}
+
+	output, err := conn.EvaluateCode(ctx, input)
+
+	if errs.IsA[*awstypes.AccessDeniedException](err) {
+		log.Printf("[WARN] Skipping AppSync code validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating AppSync code: %w", err)
+	}
+
+	if output.Error == nil || len(output.Error.CodeErrors) == 0 {
+		return nil
+	}
+
+	var codeErrs []string
+	for _, v := range output.Error.CodeErrors {
+		msg := fmt.Sprintf("%s: %s", aws.ToString(v.ErrorType), aws.ToString(v.Value))
+		if v.Location != nil {
+			msg = fmt.Sprintf("line %d, column %d: %s", v.Location.Line, v.Location.Column, msg)
+		}
+		codeErrs = append(codeErrs, msg)
+	}
+
+	return fmt.Errorf("invalid AppSync code:\n%s", strings.Join(codeErrs, "\n"))
+}
+
 func expandRuntime(tfList []interface{}) *awstypes.AppSyncRuntime {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -202,6 +202,25 @@ func testAccFunction_responseMappingTemplate(t *testing.T) {
 	})
 }
 
+func testAccFunction_codeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_code(rName1, rName2, "test-fixtures/test-code-invalid.js"),
+				ExpectError: regexache.MustCompile(`invalid AppSync code`),
+			},
+		},
+	})
+}
+
 func testAccFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
@@ -39,6 +39,8 @@ func resourceResolver() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateCode,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -69,6 +69,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_appsync_resolver",
 			Name:     "Resolver",
 		},
+		{
+			Factory:  resourceSourceAPIAssociation,
+			TypeName: "aws_appsync_source_api_association",
+			Name:     "Source API Association",
+		},
 		{
 			Factory:  resourceType,
 			TypeName: "aws_appsync_type",
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appsync_source_api_association", name="Source API Association")
+func resourceSourceAPIAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSourceAPIAssociationCreate,
+		ReadWithoutTimeout:   resourceSourceAPIAssociationRead,
+		UpdateWithoutTimeout: resourceSourceAPIAssociationUpdate,
+		DeleteWithoutTimeout: resourceSourceAPIAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"last_successful_merge_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merge_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"merged_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.MergeTypeManualMerge,
+							ValidateDiagFunc: enum.Validate[awstypes.MergeType](),
+						},
+					},
+				},
+			},
+			"source_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSourceAPIAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	mergedAPIID, sourceAPIID := d.Get("merged_api_id").(string), d.Get("source_api_id").(string)
+	input := &appsync.AssociateSourceGraphqlApiInput{
+		MergedApiIdentifier: aws.String(mergedAPIID),
+		SourceApiIdentifier: aws.String(sourceAPIID),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+	}
+
+	output, err := conn.AssociateSourceGraphqlApi(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Source API Association (%s/%s): %s", mergedAPIID, sourceAPIID, err)
+	}
+
+	association := output.SourceApiAssociation
+	d.SetId(sourceAPIAssociationCreateResourceID(aws.ToString(association.MergedApiId), aws.ToString(association.AssociationId)))
+
+	if _, err := waitSourceAPIAssociationMerged(ctx, conn, aws.ToString(association.MergedApiId), aws.ToString(association.AssociationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	mergedAPIID, associationID, err := sourceAPIAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	association, err := findSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set(names.AttrDescription, association.Description)
+	if association.LastSuccessfulMergeDate != nil {
+		d.Set("last_successful_merge_date", aws.ToTime(association.LastSuccessfulMergeDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_successful_merge_date", nil)
+	}
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", association.MergedApiId)
+	d.Set("source_api_arn", association.SourceApiArn)
+	if err := d.Set("source_api_association_config", flattenSourceAPIAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_api_association_config: %s", err)
+	}
+	d.Set("source_api_id", association.SourceApiId)
+
+	return diags
+}
+
+func resourceSourceAPIAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	mergedAPIID, associationID, err := sourceAPIAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrDescription, "source_api_association_config") {
+		input := &appsync.UpdateSourceApiAssociationInput{
+			AssociationId:       aws.String(associationID),
+			Description:         aws.String(d.Get(names.AttrDescription).(string)),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		}
+
+		if v, ok := d.GetOk("source_api_association_config"); ok {
+			input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateSourceApiAssociation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppSync Source API Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSourceAPIAssociationMerged(ctx, conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("merge_triggers") {
+		input := &appsync.StartSchemaMergeInput{
+			AssociationId:       aws.String(associationID),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		}
+
+		_, err := conn.StartSchemaMerge(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "starting AppSync Source API Association (%s) schema merge: %s", d.Id(), err)
+		}
+
+		if _, err := waitSourceAPIAssociationMerged(ctx, conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) schema merge: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	mergedAPIID, associationID, err := sourceAPIAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApi(ctx, &appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationDeleted(ctx, conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const sourceAPIAssociationResourceIDSeparator = ":"
+
+func sourceAPIAssociationCreateResourceID(mergedAPIID, associationID string) string {
+	parts := []string{mergedAPIID, associationID}
+	id := strings.Join(parts, sourceAPIAssociationResourceIDSeparator)
+
+	return id
+}
+
+func sourceAPIAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sourceAPIAssociationResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MERGED-API-ID%[2]sASSOCIATION-ID", id, sourceAPIAssociationResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findSourceAPIAssociationByTwoPartKey(ctx context.Context, conn *appsync.Client, mergedAPIID, associationID string) (*awstypes.SourceApiAssociation, error) {
+	input := &appsync.GetSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	output, err := conn.GetSourceApiAssociation(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SourceApiAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SourceApiAssociation, nil
+}
+
+func statusSourceAPIAssociation(ctx context.Context, conn *appsync.Client, mergedAPIID, associationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SourceApiAssociationStatus), nil
+	}
+}
+
+func waitSourceAPIAssociationMerged(ctx context.Context, conn *appsync.Client, mergedAPIID, associationID string, timeout time.Duration) (*awstypes.SourceApiAssociation, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SourceApiAssociationStatusMergeScheduled, awstypes.SourceApiAssociationStatusMergeInProgress),
+		Target:  enum.Slice(awstypes.SourceApiAssociationStatusMergeSuccess),
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.SourceApiAssociationStatusDetail)))
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceAPIAssociationDeleted(ctx context.Context, conn *appsync.Client, mergedAPIID, associationID string, timeout time.Duration) (*awstypes.SourceApiAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SourceApiAssociationStatusDeletionScheduled, awstypes.SourceApiAssociationStatusDeletionInProgress),
+		Target:  []string{},
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.SourceApiAssociationStatusDetail)))
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSourceAPIAssociationConfig(tfList []interface{}) *awstypes.SourceApiAssociationConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.SourceApiAssociationConfig{}
+
+	if v, ok := tfMap["merge_type"].(string); ok && v != "" {
+		apiObject.MergeType = awstypes.MergeType(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceAPIAssociationConfig(apiObject *awstypes.SourceApiAssociationConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"merge_type": apiObject.MergeType,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSourceAPIAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association awstypes.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "first", string(awstypes.MergeTypeManualMerge)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_successful_merge_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_arn", "aws_appsync_graphql_api.merged", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_id", "aws_appsync_graphql_api.merged", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_arn", "aws_appsync_graphql_api.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", string(awstypes.MergeTypeManualMerge)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_id", "aws_appsync_graphql_api.source", names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"merge_triggers"},
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "second", string(awstypes.MergeTypeAutoMerge)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", string(awstypes.MergeTypeAutoMerge)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association awstypes.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "first", string(awstypes.MergeTypeManualMerge)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappsync.ResourceSourceAPIAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_mergeTriggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association awstypes.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeTriggers(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "merge_triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "merge_triggers.schema", "aws_appsync_graphql_api.source", names.AttrSchema),
+				),
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeTriggers(rName, "title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "merge_triggers.schema", "aws_appsync_graphql_api.source", names.AttrSchema),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceAPIAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appsync_source_api_association" {
+				continue
+			}
+
+			_, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["merged_api_id"], rs.Primary.Attributes["association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppSync Source API Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSourceAPIAssociationExists(ctx context.Context, n string, v *awstypes.SourceApiAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		output, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["merged_api_id"], rs.Primary.Attributes["association_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceAPIAssociationConfig_base(rName, fieldName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "appsync.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["appsync:SourceGraphQL", "appsync:StartSchemaMerge"]
+      Resource = "arn:${data.aws_partition.current.partition}:appsync:*:*:apis/*"
+    }]
+  })
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = "%[1]s-source"
+
+  schema = <<EOF
+type Post {
+  id: ID!
+  %[2]s: String
+}
+
+type Query {
+  posts: [Post]
+}
+
+schema {
+  query: Query
+}
+EOF
+}
+
+resource "aws_appsync_graphql_api" "merged" {
+  authentication_type           = "API_KEY"
+  name                          = "%[1]s-merged"
+  api_type                      = "MERGED"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, fieldName)
+}
+
+func testAccSourceAPIAssociationConfig_basic(rName, description, mergeType string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName, "name"), fmt.Sprintf(`
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+  description   = %[1]q
+
+  source_api_association_config {
+    merge_type = %[2]q
+  }
+}
+`, description, mergeType))
+}
+
+func testAccSourceAPIAssociationConfig_mergeTriggers(rName, fieldName string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName, fieldName), `
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  merge_triggers = {
+    schema = aws_appsync_graphql_api.source.schema
+  }
+}
+`)
+}
@@ -0,0 +1,16 @@
+/**
+ * Copyright (c) HashiCorp, Inc.
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+export function request(ctx) {
+  try {
+    return {};
+  } catch (e) {
+    return { error: e };
+  }
+}
+
+export function response(ctx) {
+  return ctx.result;
+}
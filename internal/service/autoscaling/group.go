@@ -315,6 +315,11 @@ func resourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -354,6 +359,30 @@ func resourceGroup() *schema.Resource {
 				},
 				ExactlyOneOf: []string{"launch_configuration", names.AttrLaunchTemplate, "mixed_instances_policy"},
 			},
+			"latest_instance_refresh": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"percentage_complete": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"load_balancers": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -1293,6 +1322,23 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
 	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	// The latest instance refresh is only of interest when Terraform is starting refreshes.
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 {
+		output, err := findLatestInstanceRefresh(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("latest_instance_refresh", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			if err := d.Set("latest_instance_refresh", []interface{}{flattenInstanceRefresh(output)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting latest_instance_refresh: %s", err)
+			}
+		}
+	} else {
+		d.Set("latest_instance_refresh", nil)
+	}
 	if g.LaunchTemplate != nil {
 		if err := d.Set(names.AttrLaunchTemplate, []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
@@ -1660,9 +1706,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}), true)
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if v, ok := tfMap["wait_for_completion"].(bool); ok && v {
+				if _, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -2095,6 +2149,33 @@ func findInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *a
 	return tfresource.AssertSingleValueResult(output)
 }
 
+func findLatestInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name string) (*awstypes.InstanceRefresh, error) {
+	// Instance refreshes are returned most recent first.
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	}
+
+	output, err := conn.DescribeInstanceRefreshes(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, errCodeValidationError, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.InstanceRefreshes[0], nil
+}
+
 func findInstanceRefreshes(ctx context.Context, conn *autoscaling.Client, input *autoscaling.DescribeInstanceRefreshesInput) ([]awstypes.InstanceRefresh, error) {
 	var output []awstypes.InstanceRefresh
 
@@ -2702,6 +2783,29 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration) (*awstypes.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InstanceRefreshStatusInProgress,
+			awstypes.InstanceRefreshStatusPending,
+		),
+		Target:  enum.Slice(awstypes.InstanceRefreshStatusSuccessful),
+		Refresh: statusInstanceRefresh(ctx, conn, name, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.WarmPoolStatusPendingDelete),
@@ -3552,6 +3656,30 @@ func flattenInstanceMaintenancePolicy(instanceMaintenancePolicy *awstypes.Instan
 	return []interface{}{m}
 }
 
+func flattenInstanceRefresh(apiObject *awstypes.InstanceRefresh) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrStatus: string(apiObject.Status),
+	}
+
+	if v := apiObject.InstanceRefreshId; v != nil {
+		tfMap[names.AttrID] = aws.ToString(v)
+	}
+
+	if v := apiObject.PercentageComplete; v != nil {
+		tfMap["percentage_complete"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.StatusReason; v != nil {
+		tfMap[names.AttrStatusReason] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
 func flattenLaunchTemplate(apiObject *awstypes.LaunchTemplate) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -3998,10 +4126,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name s
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.ToString(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefresh(ctx, input)
 		},
@@ -4018,10 +4146,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.ToString(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
@@ -1574,6 +1574,43 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_instanceRefreshWaitForCompletion(rName, acctest.ResourcePrefix+"-1-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_completion", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct0),
+					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_instanceRefreshWaitForCompletion(rName, acctest.ResourcePrefix+"-2-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.0.percentage_complete", "100"),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.0.status", string(awstypes.InstanceRefreshStatusSuccessful)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_triggers(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.AutoScalingGroup
@@ -5509,6 +5546,48 @@ resource "aws_launch_configuration" "test" {
 `, rName, launchConfigurationNamePrefix))
 }
 
+func testAccGroupConfig_instanceRefreshWaitForCompletion(rName, launchConfigurationNamePrefix string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy            = "Rolling"
+    wait_for_completion = true
+
+    preferences {
+      instance_warmup        = 0
+      min_healthy_percentage = 0
+    }
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name_prefix   = %[2]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = "t3.nano"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, launchConfigurationNamePrefix))
+}
+
 func testAccGroupConfig_instanceRefreshTriggers(rName string) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
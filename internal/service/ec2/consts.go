@@ -258,6 +258,10 @@ const (
 	defaultSnapshotImportRoleName = "vmimport"
 )
 
+const (
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
 const (
 	launchTemplateVersionDefault = "$Default"
 	launchTemplateVersionLatest  = "$Latest"
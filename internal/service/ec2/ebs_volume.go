@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -35,7 +36,7 @@ func resourceEBSVolume() *schema.Resource {
 		CreateWithoutTimeout: resourceEBSVolumeCreate,
 		ReadWithoutTimeout:   resourceEBSVolumeRead,
 		UpdateWithoutTimeout: resourceEBSVolumeUpdate,
-		DeleteWithoutTimeout: resourceEBSVolumeDelete,
+		DeleteWithoutTimeout: sdkv2.SkipDestroyDeleteFunc("EBS Volume", resourceEBSVolumeDelete),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -68,6 +69,10 @@ func resourceEBSVolume() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"fast_restored": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -109,8 +114,9 @@ func resourceEBSVolume() *schema.Resource {
 				ForceNew:     true,
 				AtLeastOneOf: []string{names.AttrSize, names.AttrSnapshotID},
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrSkipDestroy: sdkv2.SkipDestroySchema(),
+			names.AttrTags:        tftags.TagsSchema(),
+			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 			names.AttrThroughput: {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -213,6 +219,7 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrARN, arn.String())
 	d.Set(names.AttrAvailabilityZone, volume.AvailabilityZone)
 	d.Set(names.AttrEncrypted, volume.Encrypted)
+	d.Set("fast_restored", volume.FastRestored)
 	d.Set(names.AttrIOPS, volume.Iops)
 	d.Set(names.AttrKMSKeyID, volume.KmsKeyId)
 	d.Set("multi_attach_enabled", volume.MultiAttachEnabled)
@@ -224,6 +231,8 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	setTagsOut(ctx, volume.Tags)
 
+	sdkv2.SetSkipDestroy(d)
+
 	return diags
 }
 
@@ -231,7 +240,7 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrSkipDestroy, names.AttrTags, names.AttrTagsAll) {
 		input := &ec2.ModifyVolumeInput{
 			VolumeId: aws.String(d.Id()),
 		}
@@ -45,6 +45,10 @@ func dataSourceEBSVolume() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"fast_restored": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrFilter: customFiltersSchema(),
 			names.AttrIOPS: {
 				Type:     schema.TypeInt,
@@ -144,6 +148,7 @@ func dataSourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrARN, arn.String())
 	d.Set(names.AttrAvailabilityZone, volume.AvailabilityZone)
 	d.Set(names.AttrEncrypted, volume.Encrypted)
+	d.Set("fast_restored", volume.FastRestored)
 	d.Set(names.AttrIOPS, volume.Iops)
 	d.Set(names.AttrKMSKeyID, volume.KmsKeyId)
 	d.Set("multi_attach_enabled", volume.MultiAttachEnabled)
@@ -39,6 +39,7 @@ func TestAccEC2EBSVolume_basic(t *testing.T) {
 					testAccCheckVolumeExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`volume/vol-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "fast_restored", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrIOPS, "100"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtFalse),
@@ -718,6 +719,7 @@ func TestAccEC2EBSVolume_gp3ToGP2(t *testing.T) {
 					testAccCheckVolumeExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`volume/vol-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "fast_restored", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrIOPS, "100"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtFalse),
@@ -811,6 +813,7 @@ func TestAccEC2EBSVolume_snapshotID(t *testing.T) {
 					testAccCheckVolumeExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`volume/vol-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "fast_restored", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrIOPS, "100"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtFalse),
@@ -833,6 +836,36 @@ func TestAccEC2EBSVolume_snapshotID(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_fastSnapshotRestore(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_fastSnapshotRestore(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fast_restored", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, "aws_ebs_snapshot.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"final_snapshot"},
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSVolume_snapshotIDAndSize(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Volume
@@ -852,6 +885,7 @@ func TestAccEC2EBSVolume_snapshotIDAndSize(t *testing.T) {
 					testAccCheckVolumeExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`volume/vol-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "fast_restored", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrIOPS, "100"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtFalse),
@@ -909,6 +943,33 @@ func TestAccEC2EBSVolume_finalSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_skipDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+			{
+				Config: "# Empty config",
+				Check:  testAccCheckVolumeRetained(ctx, &v),
+			},
+		},
+	})
+}
+
 func testAccCheckVolumeDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -960,6 +1021,25 @@ func testAccCheckVolumeExists(ctx context.Context, n string, v *awstypes.Volume)
 	}
 }
 
+// testAccCheckVolumeRetained checks that the EBS Volume still exists after being removed from the configuration and then deletes it.
+func testAccCheckVolumeRetained(ctx context.Context, v *awstypes.Volume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		id := aws.ToString(v.VolumeId)
+
+		if _, err := tfec2.FindEBSVolumeByID(ctx, conn, id); err != nil {
+			return fmt.Errorf("EBS Volume %s not retained: %w", id, err)
+		}
+
+		_, err := conn.DeleteVolume(ctx, &ec2.DeleteVolumeInput{
+			VolumeId: aws.String(id),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckVolumeFinalSnapshotExists(ctx context.Context, v *awstypes.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -1382,6 +1462,43 @@ resource "aws_ebs_volume" "test" {
 `, rName))
 }
 
+func testAccEBSVolumeConfig_fastSnapshotRestore(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "source" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.source.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_fast_snapshot_restore" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  snapshot_id       = aws_ebs_snapshot.test.id
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = aws_ebs_fast_snapshot_restore.test.availability_zone
+  snapshot_id       = aws_ebs_fast_snapshot_restore.test.snapshot_id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccEBSVolumeConfig_snapshotIdAndSize(rName string, size int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
@@ -1428,3 +1545,19 @@ resource "aws_ebs_volume" "test" {
 }
 `, rName))
 }
+
+func testAccEBSVolumeConfig_skipDestroy(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+  skip_destroy      = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_address_transfer", name="Address Transfer")
+func newAddressTransferResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &addressTransferResource{}, nil
+}
+
+type addressTransferResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*addressTransferResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_address_transfer"
+}
+
+func (r *addressTransferResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"address_transfer_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"allocation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transfer_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"transfer_offer_accepted_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"transfer_offer_expiration_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *addressTransferResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data addressTransferResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	allocationID := data.AllocationID.ValueString()
+	input := &ec2.EnableAddressTransferInput{
+		AllocationId:      fwflex.StringFromFramework(ctx, data.AllocationID),
+		TransferAccountId: fwflex.StringFromFramework(ctx, data.TransferAccountID),
+	}
+
+	output, err := conn.EnableAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Address Transfer (%s)", allocationID), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(allocationID)
+	data.setFromAddressTransfer(ctx, output.AddressTransfer)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *addressTransferResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data addressTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findAddressTransferByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		// Completed transfers are only reported for a limited time and the address no longer belongs to this account.
+		if data.AddressTransferStatus.ValueString() == string(awstypes.AddressTransferStatusAccepted) {
+			return
+		}
+
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Address Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+	data.TransferAccountID = fwflex.StringToFramework(ctx, output.TransferAccountId)
+	data.setFromAddressTransfer(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *addressTransferResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data addressTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted transfer cannot be reverted.
+	if data.AddressTransferStatus.ValueString() == string(awstypes.AddressTransferStatusAccepted) {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DisableAddressTransfer(ctx, &ec2.DisableAddressTransferInput{
+		AllocationId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Address Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type addressTransferResourceModel struct {
+	AddressTransferStatus            types.String      `tfsdk:"address_transfer_status"`
+	AllocationID                     types.String      `tfsdk:"allocation_id"`
+	ID                               types.String      `tfsdk:"id"`
+	PublicIP                         types.String      `tfsdk:"public_ip"`
+	TransferAccountID                types.String      `tfsdk:"transfer_account_id"`
+	TransferOfferAcceptedTimestamp   timetypes.RFC3339 `tfsdk:"transfer_offer_accepted_timestamp"`
+	TransferOfferExpirationTimestamp timetypes.RFC3339 `tfsdk:"transfer_offer_expiration_timestamp"`
+}
+
+func (data *addressTransferResourceModel) setFromAddressTransfer(ctx context.Context, apiObject *awstypes.AddressTransfer) {
+	data.AddressTransferStatus = fwflex.StringValueToFramework(ctx, apiObject.AddressTransferStatus)
+	data.PublicIP = fwflex.StringToFramework(ctx, apiObject.PublicIp)
+	data.TransferOfferAcceptedTimestamp = fwflex.TimeToFramework(ctx, apiObject.TransferOfferAcceptedTimestamp)
+	data.TransferOfferExpirationTimestamp = fwflex.TimeToFramework(ctx, apiObject.TransferOfferExpirationTimestamp)
+}
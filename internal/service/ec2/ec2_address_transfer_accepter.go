@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_address_transfer_accepter", name="Address Transfer Accepter")
+func newAddressTransferAccepterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &addressTransferAccepterResource{}, nil
+}
+
+type addressTransferAccepterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (*addressTransferAccepterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_address_transfer_accepter"
+}
+
+func (r *addressTransferAccepterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAddress: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.IPv4Address(),
+				},
+			},
+			"allocation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *addressTransferAccepterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data addressTransferAccepterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	address := data.Address.ValueString()
+	input := &ec2.AcceptAddressTransferInput{
+		Address: fwflex.StringFromFramework(ctx, data.Address),
+	}
+
+	_, err := conn.AcceptAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("accepting EC2 Address Transfer (%s)", address), err.Error())
+
+		return
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return findEIPByPublicIP(ctx, conn, address)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP (%s) create", address), err.Error())
+
+		return
+	}
+
+	eip := outputRaw.(*awstypes.Address)
+	data.AllocationID = fwflex.StringToFramework(ctx, eip.AllocationId)
+	data.ID = types.StringValue(address)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *addressTransferAccepterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data addressTransferAccepterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPByPublicIP(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Address = fwflex.StringToFramework(ctx, output.PublicIp)
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *addressTransferAccepterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrAddress), request, response)
+}
+
+type addressTransferAccepterResourceModel struct {
+	Address      types.String `tfsdk:"address"`
+	AllocationID types.String `tfsdk:"allocation_id"`
+	ID           types.String `tfsdk:"id"`
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AddressTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_address_transfer.test"
+	eipResourceName := "aws_eip.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckAddressTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2AddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressTransferExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", "pending"),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2AddressTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_address_transfer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckAddressTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2AddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressTransferExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceAddressTransfer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2AddressTransferAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Accepting the transfer moves the address into the alternate account, where it must be released manually.
+	acctest.SkipIfEnvVarNotSet(t, "EC2_ADDRESS_TRANSFER_ACCEPT")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_address_transfer_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2AddressTransferAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAddress, "aws_ec2_address_transfer.test", "public_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "allocation_id"),
+					resource.TestCheckResourceAttr("aws_ec2_address_transfer.test", "address_transfer_status", "accepted"),
+				),
+				// The transfer's status is refreshed after acceptance.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAddressTransferExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAddressTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_address_transfer" {
+				continue
+			}
+
+			_, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Address Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEC2AddressTransferConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_ec2_address_transfer" "test" {
+  allocation_id       = aws_eip.test.id
+  transfer_account_id = data.aws_caller_identity.alternate.account_id
+}
+`, rName))
+}
+
+func testAccEC2AddressTransferConfig_basic(rName string) string {
+	return testAccEC2AddressTransferConfig_base(rName)
+}
+
+func testAccEC2AddressTransferAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEC2AddressTransferConfig_base(rName), `
+resource "aws_ec2_address_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_ec2_address_transfer.test.public_ip
+}
+`)
+}
@@ -49,7 +49,11 @@ func resourceAMI() *schema.Resource {
 		DeleteWithoutTimeout: resourceAMIDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("delete_associated_snapshots", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -84,6 +88,12 @@ func resourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"delete_associated_snapshots": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deregistration_protection": amiDeregistrationProtectionSchema(),
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -384,6 +394,12 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if enabled, withCooldown := expandImageDeregistrationProtection(d.Get("deregistration_protection").([]interface{})); enabled {
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), enabled, withCooldown); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -431,6 +447,9 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	if err := d.Set("deregistration_protection", flattenImageDeregistrationProtection(aws.ToString(image.DeregistrationProtection), d.Get("deregistration_protection").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deregistration_protection: %s", err)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -487,6 +506,14 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("deregistration_protection") {
+		enabled, withCooldown := expandImageDeregistrationProtection(d.Get("deregistration_protection").([]interface{}))
+
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), enabled, withCooldown); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -507,8 +534,9 @@ func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "deregistering EC2 AMI (%s): %s", d.Id(), err)
 	}
 
-	// If we're managing the EBS snapshots then we need to delete those too.
-	if d.Get("manage_ebs_snapshots").(bool) {
+	// If we're managing the EBS snapshots, or have been asked to clean them up, then we need to delete those too.
+	// delete_associated_snapshots is only defined on aws_ami.
+	if v, ok := d.GetOk("delete_associated_snapshots"); d.Get("manage_ebs_snapshots").(bool) || (ok && v.(bool)) {
 		errs := map[string]error{}
 		ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
 		req := &ec2.DeleteSnapshotInput{}
@@ -604,6 +632,88 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.Client, id string) e
 	return nil
 }
 
+func amiDeregistrationProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrEnabled: {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"with_cooldown": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func updateImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string, enabled, withCooldown bool) error {
+	if enabled {
+		input := &ec2.EnableImageDeregistrationProtectionInput{
+			ImageId:      aws.String(id),
+			WithCooldown: aws.Bool(withCooldown),
+		}
+
+		if _, err := conn.EnableImageDeregistrationProtection(ctx, input); err != nil {
+			return fmt.Errorf("enabling deregistration protection: %w", err)
+		}
+
+		return nil
+	}
+
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	if _, err := conn.DisableImageDeregistrationProtection(ctx, input); err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
+func expandImageDeregistrationProtection(tfList []interface{}) (bool, bool) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false, false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return tfMap[names.AttrEnabled].(bool), tfMap["with_cooldown"].(bool)
+}
+
+// flattenImageDeregistrationProtection converts the image's deregistration protection status,
+// e.g. "enabled-with-cooldown" or "disabled-until 2024-05-01T00:00:00.000Z", into the configuration block.
+// The cooldown setting cannot be observed once protection is disabled, so the prior value is kept.
+func flattenImageDeregistrationProtection(status string, old []interface{}) []interface{} {
+	var oldMap map[string]interface{}
+	if len(old) > 0 && old[0] != nil {
+		oldMap = old[0].(map[string]interface{})
+	}
+
+	if strings.HasPrefix(status, "enabled") {
+		return []interface{}{map[string]interface{}{
+			names.AttrEnabled: true,
+			"with_cooldown":   status == imageDeregistrationProtectionEnabledWithCooldown,
+		}}
+	}
+
+	if oldMap == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrEnabled: false,
+		"with_cooldown":   oldMap["with_cooldown"],
+	}}
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) awstypes.BlockDeviceMapping {
 	apiObject := awstypes.BlockDeviceMapping{
 		Ebs: &awstypes.EbsBlockDevice{},
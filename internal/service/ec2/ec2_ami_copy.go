@@ -62,6 +62,7 @@ func resourceAMICopy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": amiDeregistrationProtectionSchema(),
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -316,5 +317,11 @@ func resourceAMICopyCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if enabled, withCooldown := expandImageDeregistrationProtection(d.Get("deregistration_protection").([]interface{})); enabled {
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), enabled, withCooldown); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from source EC2 AMI (%s): %s", name, sourceImageID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
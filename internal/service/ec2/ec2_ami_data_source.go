@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -89,6 +90,12 @@ func dataSourceAMI() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"exclude_deprecated": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"include_deprecated"},
+			},
 			"executable_users": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -276,6 +283,10 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		filteredImages = images[:]
 	}
 
+	if d.Get("exclude_deprecated").(bool) {
+		filteredImages = excludeDeprecatedImages(filteredImages, time.Now())
+	}
+
 	if len(filteredImages) < 1 {
 		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
 	}
@@ -421,3 +432,17 @@ func flattenAMIStateReason(m *awstypes.StateReason) map[string]interface{} {
 	}
 	return s
 }
+
+// excludeDeprecatedImages removes images whose deprecation time has passed.
+// DescribeImages always returns the caller's own deprecated images, whatever the value of IncludeDeprecated.
+func excludeDeprecatedImages(images []awstypes.Image, now time.Time) []awstypes.Image {
+	return tfslices.Filter(images, func(v awstypes.Image) bool {
+		if v.DeprecationTime == nil {
+			return true
+		}
+
+		t, err := time.Parse(time.RFC3339, aws.ToString(v.DeprecationTime))
+
+		return err != nil || t.After(now)
+	})
+}
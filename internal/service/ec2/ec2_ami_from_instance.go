@@ -62,6 +62,7 @@ func resourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": amiDeregistrationProtectionSchema(),
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -285,5 +286,11 @@ func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if enabled, withCooldown := expandImageDeregistrationProtection(d.Get("deregistration_protection").([]interface{})); enabled {
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), enabled, withCooldown); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
@@ -33,6 +33,12 @@ func dataSourceAMIIDs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"exclude_deprecated": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"include_deprecated"},
+			},
 			"executable_users": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -118,6 +124,10 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta inte
 		filteredImages = images[:]
 	}
 
+	if d.Get("exclude_deprecated").(bool) {
+		filteredImages = excludeDeprecatedImages(filteredImages, time.Now())
+	}
+
 	sort.Slice(filteredImages, func(i, j int) bool {
 		itime, _ := time.Parse(time.RFC3339, aws.ToString(filteredImages[i].CreationDate))
 		jtime, _ := time.Parse(time.RFC3339, aws.ToString(filteredImages[j].CreationDate))
@@ -8,8 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -83,6 +88,45 @@ func TestAccEC2AMIIDsDataSource_includeDeprecated(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIIDsDataSource_excludeDeprecated(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ami_ids.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIIDsDataSourceConfig_excludeDeprecated,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(datasourceName, "ids.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestExcludeDeprecatedImages(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	images := []awstypes.Image{
+		{ImageId: aws.String("ami-none")},
+		{ImageId: aws.String("ami-past"), DeprecationTime: aws.String("2024-05-01T00:00:00.000Z")},
+		{ImageId: aws.String("ami-future"), DeprecationTime: aws.String("2024-07-01T00:00:00.000Z")},
+	}
+
+	got := tfslices.ApplyToAll(tfec2.ExcludeDeprecatedImages(images, now), func(v awstypes.Image) string {
+		return aws.ToString(v.ImageId)
+	})
+	want := []string{"ami-none", "ami-future"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
 const testAccAMIIDsDataSourceConfig_basic = `
 data "aws_ami_ids" "test" {
   owners = ["099720109477"]
@@ -147,3 +191,15 @@ data "aws_ami_ids" "test" {
 }
 `, includeDeprecated)
 }
+
+const testAccAMIIDsDataSourceConfig_excludeDeprecated = `
+data "aws_ami_ids" "test" {
+  owners             = ["099720109477"]
+  exclude_deprecated = true
+
+  filter {
+    name   = "name"
+    values = ["ubuntu/images/hvm-instance/ubuntu-*"]
+  }
+}
+`
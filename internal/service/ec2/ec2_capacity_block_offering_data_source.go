@@ -45,6 +45,10 @@ func (d *capacityBlockOfferingDataSource) Schema(_ context.Context, _ datasource
 			"currency_code": schema.StringAttribute{
 				Computed: true,
 			},
+			"end_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
 			"end_date_range": schema.StringAttribute{
 				CustomType: timetypes.RFC3339Type{},
 				Optional:   true,
@@ -56,6 +60,10 @@ func (d *capacityBlockOfferingDataSource) Schema(_ context.Context, _ datasource
 			names.AttrInstanceType: schema.StringAttribute{
 				Required: true,
 			},
+			"start_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
 			"start_date_range": schema.StringAttribute{
 				CustomType: timetypes.RFC3339Type{},
 				Optional:   true,
@@ -106,10 +114,12 @@ type capacityBlockOfferingDataSourceModel struct {
 	AvailabilityZone        types.String      `tfsdk:"availability_zone"`
 	CapacityDurationHours   types.Int64       `tfsdk:"capacity_duration_hours"`
 	CurrencyCode            types.String      `tfsdk:"currency_code"`
+	EndDate                 timetypes.RFC3339 `tfsdk:"end_date"`
 	EndDateRange            timetypes.RFC3339 `tfsdk:"end_date_range"`
 	CapacityBlockOfferingID types.String      `tfsdk:"capacity_block_offering_id"`
 	InstanceCount           types.Int64       `tfsdk:"instance_count"`
 	InstanceType            types.String      `tfsdk:"instance_type"`
+	StartDate               timetypes.RFC3339 `tfsdk:"start_date"`
 	StartDateRange          timetypes.RFC3339 `tfsdk:"start_date_range"`
 	Tenancy                 types.String      `tfsdk:"tenancy"`
 	UpfrontFee              types.String      `tfsdk:"upfront_fee"`
@@ -35,6 +35,8 @@ func TestAccEC2CapacityBlockOfferingDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrInstanceCount, acctest.Ct1),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrInstanceType, "p4d.24xlarge"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
 					resource.TestCheckResourceAttr(dataSourceName, "tenancy", "default"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "upfront_fee"),
 				),
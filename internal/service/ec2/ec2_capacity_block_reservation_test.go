@@ -12,6 +12,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -50,7 +51,7 @@ func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "start_date", resourceName, "start_date"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "end_date", resourceName, "end_date"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceCount, resourceName, names.AttrInstanceCount),
-					resource.TestCheckResourceAttrPair(dataSourceName, "instance_platform", resourceName, "instance_platform"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceType, resourceName, names.AttrInstanceType),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tenancy", resourceName, "tenancy"),
 				),
@@ -59,6 +60,40 @@ func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2CapacityBlockReservation_capacityReservationGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RUN_EC2_CAPACITY_BLOCK_RESERVATION_TESTS"
+	vifId := os.Getenv(key)
+	if vifId != acctest.CtTrue {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation awstypes.CapacityReservation
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+	groupResourceName := "aws_resourcegroups_group.test"
+	membershipResourceName := "aws_resourcegroups_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	startDate := time.Now().UTC().Add(25 * time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(720 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig_capacityReservationGroup(rName, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttrPair(membershipResourceName, "group_arn", groupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(membershipResourceName, names.AttrResourceARN, resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string, v *awstypes.CapacityReservation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -83,15 +118,15 @@ func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string, v
 func testAccCapacityBlockReservationConfig_basic(startDate, endDate string) string {
 	return fmt.Sprintf(`
 data "aws_ec2_capacity_block_offering" "test" {
-  instance_type     = "p4d.24xlarge"
-  capacity_duration = 24
-  instance_count    = 1
-  start_date        = %[1]q
-  end_date          = %[2]q
+  instance_type           = "p4d.24xlarge"
+  capacity_duration_hours = 24
+  instance_count          = 1
+  start_date_range        = %[1]q
+  end_date_range          = %[2]q
 }
 
 resource "aws_ec2_capacity_block_reservation" "test" {
-  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.id
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
   instance_platform          = "Linux/UNIX"
   tags = {
     "Environment" = "dev"
@@ -99,3 +134,29 @@ resource "aws_ec2_capacity_block_reservation" "test" {
 }
 `, startDate, endDate)
 }
+
+func testAccCapacityBlockReservationConfig_capacityReservationGroup(rName, startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccCapacityBlockReservationConfig_basic(startDate, endDate), fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name   = "allowed-resource-types"
+      values = ["AWS::EC2::CapacityReservation"]
+    }
+  }
+}
+
+resource "aws_resourcegroups_resource" "test" {
+  group_arn    = aws_resourcegroups_group.test.arn
+  resource_arn = aws_ec2_capacity_block_reservation.test.arn
+}
+`, rName))
+}
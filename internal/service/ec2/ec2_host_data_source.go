@@ -58,6 +58,10 @@ func dataSourceHost() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"host_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_recovery": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +132,7 @@ func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrAvailabilityZone, host.AvailabilityZone)
 	d.Set("cores", host.HostProperties.Cores)
 	d.Set("host_id", host.HostId)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set(names.AttrInstanceType, host.HostProperties.InstanceType)
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2HostsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_tags", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_hosts.by_tags", "ids.0", "aws_ec2_host.test", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_filter", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_hosts.by_filter", "ids.0", "aws_ec2_host.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "c5.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_hosts" "by_tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_host.test]
+}
+
+data "aws_ec2_hosts" "by_filter" {
+  filter {
+    name   = "instance-type"
+    values = [aws_ec2_host.test.instance_type]
+  }
+
+  filter {
+    name   = "availability-zone"
+    values = [aws_ec2_host.test.availability_zone]
+  }
+
+  filter {
+    name   = "tag:Name"
+    values = [%[1]q]
+  }
+}
+`, rName))
+}
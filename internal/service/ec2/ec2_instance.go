@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -468,6 +469,18 @@ func resourceInstance() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{names.AttrInstanceType, names.AttrLaunchTemplate},
 			},
+			"ipv4_prefix_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ipv4_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"ipv6_address_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -485,6 +498,18 @@ func resourceInstance() *schema.Resource {
 				},
 				ConflictsWith: []string{"ipv6_address_count"},
 			},
+			"ipv6_prefix_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ipv6_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"key_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -524,6 +549,30 @@ func resourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"live_resize": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"force_stop": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"maintenance_window": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+						},
+						"wait_for_status_checks": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"maintenance_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -586,7 +635,7 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"network_interface": {
-				ConflictsWith: []string{"associate_public_ip_address", names.AttrSubnetID, "private_ip", "secondary_private_ips", names.AttrVPCSecurityGroupIDs, names.AttrSecurityGroups, "ipv6_addresses", "ipv6_address_count", "source_dest_check"},
+				ConflictsWith: []string{"associate_public_ip_address", names.AttrSubnetID, "private_ip", "secondary_private_ips", names.AttrVPCSecurityGroupIDs, names.AttrSecurityGroups, "ipv6_addresses", "ipv6_address_count", "ipv4_prefix_count", "ipv6_prefix_count", "source_dest_check"},
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Computed:      true,
@@ -603,6 +652,36 @@ func resourceInstance() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"ena_srd_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"ena_srd_udp_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ena_srd_udp_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"network_card_index": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -905,42 +984,73 @@ func resourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
-			customdiff.ForceNewIf(names.AttrInstanceType, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				conn := meta.(*conns.AWSClient).EC2Client(ctx)
-
-				_, ok := diff.GetOk(names.AttrInstanceType)
+			customdiff.ForceNewIf(names.AttrInstanceType, instanceTypeChangeRequiresReplacement),
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Reject an in-place live resize planned outside of its maintenance window.
+				if diff.Id() == "" || !diff.HasChange(names.AttrInstanceType) || !diff.NewValueKnown(names.AttrInstanceType) {
+					return nil
+				}
 
-				if diff.Id() == "" || !diff.HasChange(names.AttrInstanceType) || !ok {
-					return false
+				v, ok := diff.Get("live_resize").([]interface{})
+				if !ok || len(v) == 0 || v[0] == nil {
+					return nil
 				}
 
-				o, n := diff.GetChange(names.AttrInstanceType)
-				it1, err := findInstanceTypeByName(ctx, conn, o.(string))
-				if err != nil {
-					return false
+				window, _ := v[0].(map[string]interface{})["maintenance_window"].(string)
+				if window == "" || instanceTypeChangeRequiresReplacement(ctx, diff, meta) {
+					return nil
 				}
 
-				it2, err := findInstanceTypeByName(ctx, conn, n.(string))
+				inWindow, err := inWeeklyMaintenanceWindow(window, time.Now())
 				if err != nil {
-					return false
+					return err
 				}
 
-				if it1 == nil || it2 == nil {
-					return false
+				if !inWindow {
+					return fmt.Errorf("current time is outside the live resize maintenance window (%s)", window)
 				}
 
-				if it1.InstanceType == it2.InstanceType {
-					return false
-				}
+				return nil
+			},
+		),
+	}
+}
 
-				if hasCommonElement(it1.ProcessorInfo.SupportedArchitectures, it2.ProcessorInfo.SupportedArchitectures) {
-					return false
-				}
+// instanceTypeChangeRequiresReplacement returns whether an instance type change is between
+// instance types that have no supported architecture in common.
+func instanceTypeChangeRequiresReplacement(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-				return true
-			}),
-		),
+	_, ok := diff.GetOk(names.AttrInstanceType)
+
+	if diff.Id() == "" || !diff.HasChange(names.AttrInstanceType) || !ok {
+		return false
+	}
+
+	o, n := diff.GetChange(names.AttrInstanceType)
+	it1, err := findInstanceTypeByName(ctx, conn, o.(string))
+	if err != nil {
+		return false
+	}
+
+	it2, err := findInstanceTypeByName(ctx, conn, n.(string))
+	if err != nil {
+		return false
+	}
+
+	if it1 == nil || it2 == nil {
+		return false
+	}
+
+	if it1.InstanceType == it2.InstanceType {
+		return false
+	}
+
+	if hasCommonElement(it1.ProcessorInfo.SupportedArchitectures, it2.ProcessorInfo.SupportedArchitectures) {
+		return false
 	}
+
+	return true
 }
 
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
@@ -1232,16 +1342,24 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// resources have the potential to attach network interfaces to the instance, and cause a perpetual create/destroy
 	// diff. We should only read on changes configured for this specific resource because of this.
 	var configuredDeviceIndexes []int
+	// ENA Express settings are only read back for configured network interfaces that specify them,
+	// as any other difference would change the set element and force a new instance.
+	configuredENASRDSpecifications := make(map[int]map[string]interface{})
 	if v, ok := d.GetOk("network_interface"); ok {
 		vL := v.(*schema.Set).List()
 		for _, vi := range vL {
 			mVi := vi.(map[string]interface{})
 			configuredDeviceIndexes = append(configuredDeviceIndexes, mVi["device_index"].(int))
+			if v, ok := mVi["ena_srd_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				configuredENASRDSpecifications[mVi["device_index"].(int)] = v[0].(map[string]interface{})
+			}
 		}
 	}
 
 	var secondaryPrivateIPs []string
 	var ipv6Addresses []string
+	var ipv4Prefixes []string
+	var ipv6Prefixes []string
 	if len(instance.NetworkInterfaces) > 0 {
 		var primaryNetworkInterface awstypes.InstanceNetworkInterface
 		var networkInterfaces []map[string]interface{}
@@ -1258,6 +1376,14 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 					ni["network_card_index"] = aws.ToInt32(iNi.Attachment.NetworkCardIndex)
 					ni[names.AttrNetworkInterfaceID] = aws.ToString(iNi.NetworkInterfaceId)
 					ni[names.AttrDeleteOnTermination] = aws.ToBool(iNi.Attachment.DeleteOnTermination)
+					if tfMap, ok := configuredENASRDSpecifications[index]; ok {
+						if enaSRDSpecification := flattenInstanceAttachmentENASRDSpecification(iNi.Attachment.EnaSrdSpecification); enaSRDSpecification != nil {
+							if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); !ok || len(v) == 0 {
+								delete(enaSRDSpecification, "ena_srd_udp_specification")
+							}
+							ni["ena_srd_specification"] = []interface{}{enaSRDSpecification}
+						}
+					}
 				}
 			}
 			// Don't add empty network interfaces to schema
@@ -1296,10 +1422,22 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			for _, address := range primaryNetworkInterface.Ipv6Addresses {
 				ipv6Addresses = append(ipv6Addresses, aws.ToString(address.Ipv6Address))
 			}
+
+			for _, prefix := range primaryNetworkInterface.Ipv4Prefixes {
+				ipv4Prefixes = append(ipv4Prefixes, aws.ToString(prefix.Ipv4Prefix))
+			}
+			d.Set("ipv4_prefix_count", len(primaryNetworkInterface.Ipv4Prefixes))
+
+			for _, prefix := range primaryNetworkInterface.Ipv6Prefixes {
+				ipv6Prefixes = append(ipv6Prefixes, aws.ToString(prefix.Ipv6Prefix))
+			}
+			d.Set("ipv6_prefix_count", len(primaryNetworkInterface.Ipv6Prefixes))
 		}
 	} else {
 		d.Set("associate_public_ip_address", instance.PublicIpAddress != nil)
+		d.Set("ipv4_prefix_count", 0)
 		d.Set("ipv6_address_count", 0)
+		d.Set("ipv6_prefix_count", 0)
 		d.Set("primary_network_interface_id", "")
 		d.Set(names.AttrSubnetID, instance.SubnetId)
 	}
@@ -1312,6 +1450,14 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[WARN] Error setting ipv6_addresses for AWS Instance (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("ipv4_prefixes", ipv4Prefixes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
+	}
+
+	if err := d.Set("ipv6_prefixes", ipv6Prefixes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ipv6_prefixes: %s", err)
+	}
+
 	d.Set("ebs_optimized", instance.EbsOptimized)
 	if aws.ToString(instance.SubnetId) != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
@@ -1788,7 +1934,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					},
 				}
 
-				if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, fmt.Sprintf("InstanceType (%s)", instanceType)); err != nil {
+				if v, ok := d.Get("live_resize").([]interface{}); ok && len(v) > 0 {
+					tfMap, _ := v[0].(map[string]interface{})
+
+					if err := resizeInstance(ctx, conn, input, tfMap, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
+					}
+				} else if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, fmt.Sprintf("InstanceType (%s)", instanceType)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
 				}
 			}
@@ -2234,6 +2386,106 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.Client,
 	return nil
 }
 
+// resizeInstance changes the instance type of an EC2 instance using the live resize options
+// by stopping the instance, modifying its type and then starting it again.
+func resizeInstance(ctx context.Context, conn *ec2.Client, input *ec2.ModifyInstanceAttributeInput, tfMap map[string]interface{}, timeout time.Duration) error {
+	id := aws.ToString(input.InstanceId)
+
+	if v, ok := tfMap["maintenance_window"].(string); ok && v != "" {
+		inWindow, err := inWeeklyMaintenanceWindow(v, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if !inWindow {
+			return fmt.Errorf("current time is outside the live resize maintenance window (%s)", v)
+		}
+	}
+
+	force, _ := tfMap["force_stop"].(bool)
+	if err := stopInstance(ctx, conn, id, force, timeout); err != nil {
+		return err
+	}
+
+	if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
+		err = fmt.Errorf("modifying EC2 Instance (%s) InstanceType attribute: %w", id, err)
+
+		// The instance type is unchanged, so restart the instance as it was.
+		if startErr := startInstance(ctx, conn, id, true, timeout); startErr != nil {
+			return errors.Join(err, startErr)
+		}
+
+		return err
+	}
+
+	if err := startInstance(ctx, conn, id, true, timeout); err != nil {
+		return err
+	}
+
+	if v, ok := tfMap["wait_for_status_checks"].(bool); ok && v {
+		if _, err := waitInstanceStatusChecksOK(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) status checks: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// inWeeklyMaintenanceWindow returns whether t falls within a "ddd:hh24:mi-ddd:hh24:mi" window, evaluated in UTC.
+func inWeeklyMaintenanceWindow(window string, t time.Time) (bool, error) {
+	start, end, ok := strings.Cut(window, "-")
+	if !ok {
+		return false, fmt.Errorf("maintenance window (%s) must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"", window)
+	}
+
+	startMinute, err := minuteOfWeek(start)
+	if err != nil {
+		return false, err
+	}
+
+	endMinute, err := minuteOfWeek(end)
+	if err != nil {
+		return false, err
+	}
+
+	t = t.UTC()
+	now := int(t.Weekday())*24*60 + t.Hour()*60 + t.Minute()
+
+	if startMinute <= endMinute {
+		return now >= startMinute && now < endMinute, nil
+	}
+
+	// The window wraps around the end of the week.
+	return now >= startMinute || now < endMinute, nil
+}
+
+// minuteOfWeek converts a "ddd:hh24:mi" value to the number of minutes since Sunday 00:00.
+func minuteOfWeek(v string) (int, error) {
+	days := []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+	parts := strings.Split(strings.ToLower(v), ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("unexpected format for maintenance window time (%s), expected ddd:hh24:mi", v)
+	}
+
+	day := slices.Index(days, parts[0])
+	if day == -1 {
+		return 0, fmt.Errorf("invalid day of week (%s) in maintenance window time (%s)", parts[0], v)
+	}
+
+	hour, err := strconv.Atoi(parts[1])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, fmt.Errorf("invalid hour (%s) in maintenance window time (%s)", parts[1], v)
+	}
+
+	minute, err := strconv.Atoi(parts[2])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("invalid minute (%s) in maintenance window time (%s)", parts[2], v)
+	}
+
+	return day*24*60 + hour*60 + minute, nil
+}
+
 func readBlockDevices(ctx context.Context, d *schema.ResourceData, meta interface{}, instance *awstypes.Instance, ds bool) error {
 	ibds, err := readBlockDevicesFromInstance(ctx, d, meta, instance, ds)
 	if err != nil {
@@ -2506,6 +2758,14 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfa
 			ni.Ipv6AddressCount = aws.Int32(int32(v.(int)))
 		}
 
+		if v, ok := d.GetOk("ipv4_prefix_count"); ok {
+			ni.Ipv4PrefixCount = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("ipv6_prefix_count"); ok {
+			ni.Ipv6PrefixCount = aws.Int32(int32(v.(int)))
+		}
+
 		if v, ok := d.GetOk("ipv6_addresses"); ok {
 			ipv6Addresses := make([]awstypes.InstanceIpv6Address, len(v.([]interface{})))
 			for i, address := range v.([]interface{}) {
@@ -2535,6 +2795,11 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfa
 				NetworkInterfaceId:  aws.String(ini[names.AttrNetworkInterfaceID].(string)),
 				DeleteOnTermination: aws.Bool(ini[names.AttrDeleteOnTermination].(bool)),
 			}
+
+			if v, ok := ini["ena_srd_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				ni.EnaSrdSpecification = expandENASRDSpecificationRequest(v[0].(map[string]interface{}))
+			}
+
 			networkInterfaces = append(networkInterfaces, ni)
 		}
 	}
@@ -2542,6 +2807,47 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfa
 	return networkInterfaces
 }
 
+func expandENASRDSpecificationRequest(tfMap map[string]interface{}) *awstypes.EnaSrdSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EnaSrdSpecificationRequest{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EnaSrdUdpSpecification = &awstypes.EnaSrdUdpSpecificationRequest{}
+
+		if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+			apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenInstanceAttachmentENASRDSpecification(apiObject *awstypes.InstanceAttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ena_srd_enabled": aws.ToBool(apiObject.EnaSrdEnabled),
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []interface{}{map[string]interface{}{
+			"ena_srd_udp_enabled": aws.ToBool(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
+
 func readBlockDeviceMappingsFromConfig(ctx context.Context, d *schema.ResourceData, conn *ec2.Client) ([]awstypes.BlockDeviceMapping, error) {
 	blockDevices := make([]awstypes.BlockDeviceMapping, 0)
 
@@ -3038,10 +3344,13 @@ func buildInstanceOpts(ctx context.Context, d *schema.ResourceData, meta interfa
 	_, assocPubIPA := d.GetOkExists("associate_public_ip_address")
 	_, privIP := d.GetOk("private_ip")
 	_, secPrivIP := d.GetOk("secondary_private_ips")
+	_, ipv4PrefixCount := d.GetOk("ipv4_prefix_count")
+	_, ipv6PrefixCount := d.GetOk("ipv6_prefix_count")
 	networkInterfaces, interfacesOk := d.GetOk("network_interface")
 
-	// If setting subnet and public address, OR manual network interfaces, populate those now.
-	if (hasSubnet && (assocPubIPA || privIP || secPrivIP)) || interfacesOk {
+	// If setting subnet and public address or prefixes, OR manual network interfaces, populate those now.
+	// Prefix delegation can only be requested through a network interface specification.
+	if (hasSubnet && (assocPubIPA || privIP || secPrivIP || ipv4PrefixCount || ipv6PrefixCount)) || interfacesOk {
 		// Otherwise we're attaching (a) network interface(s)
 		opts.NetworkInterfaces = buildNetworkInterfaceOpts(d, groups, networkInterfaces)
 	} else {
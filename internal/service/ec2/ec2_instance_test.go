@@ -2216,6 +2216,117 @@ func TestAccEC2Instance_changeInstanceTypeReplace(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_LiveResize_changeInstanceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_liveResize(rName, "t3.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "live_resize.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "live_resize.0.force_stop", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "live_resize.0.wait_for_status_checks", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"live_resize", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_liveResize(rName, "t3.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.large"),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_LiveResize_architectureChangeReplace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_liveResizeTypeReplace(rName, "m5.2xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "m5.2xlarge"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_liveResizeTypeReplace(rName, "m6g.2xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "m6g.2xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_LiveResize_outsideMaintenanceWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// A one minute window that started two hours ago.
+	start := time.Now().UTC().Add(-2 * time.Hour)
+	end := start.Add(time.Minute)
+	window := strings.ToLower(fmt.Sprintf("%s-%s", start.Format("Mon:15:04"), end.Format("Mon:15:04")))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_liveResizeMaintenanceWindow(rName, "t3.medium", window),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_liveResizeMaintenanceWindow(rName, "t3.large", window),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`outside the live resize maintenance window`),
+			},
+			{
+				Config: testAccInstanceConfig_liveResizeMaintenanceWindow(rName, "t3.medium", window),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.medium"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -2899,6 +3010,84 @@ func TestAccEC2Instance_networkCardIndex(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_networkInterfaceENASRDSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var instance awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_networkInterfaceENASRDSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "network_interface.*", map[string]string{
+						"device_index":                                        acctest.Ct0,
+						"ena_srd_specification.#":                             acctest.Ct1,
+						"ena_srd_specification.0.ena_srd_enabled":             acctest.CtTrue,
+						"ena_srd_specification.0.ena_srd_udp_specification.#": acctest.Ct1,
+						"ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled": acctest.CtTrue,
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"network_interface", "user_data_replace_on_change"},
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_prefixCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var instance awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_prefixCount(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_prefix_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_prefixes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_prefix_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_prefixes.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_prefixCount(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_prefix_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_prefixes.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_prefix_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_prefixes.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_primaryNetworkInterfaceSourceDestCheck(t *testing.T) {
 	ctx := acctest.Context(t)
 	var instance awstypes.Instance
@@ -5593,6 +5782,84 @@ func testAccCheckDetachVolumes(ctx context.Context, instance *awstypes.Instance)
 	}
 }
 
+func TestInWeeklyMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		window   string
+		time     time.Time
+		expected bool
+		err      bool
+	}{
+		{
+			name:     "inside",
+			window:   "mon:02:00-mon:04:00",
+			time:     time.Date(2024, time.June, 3, 3, 0, 0, 0, time.UTC), // Monday.
+			expected: true,
+		},
+		{
+			name:     "at end",
+			window:   "mon:02:00-mon:04:00",
+			time:     time.Date(2024, time.June, 3, 4, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "other day",
+			window:   "Mon:02:00-Mon:04:00",
+			time:     time.Date(2024, time.June, 4, 3, 0, 0, 0, time.UTC), // Tuesday.
+			expected: false,
+		},
+		{
+			name:     "wraps week inside",
+			window:   "sat:22:00-sun:02:00",
+			time:     time.Date(2024, time.June, 2, 1, 0, 0, 0, time.UTC), // Sunday.
+			expected: true,
+		},
+		{
+			name:     "wraps week outside",
+			window:   "sat:22:00-sun:02:00",
+			time:     time.Date(2024, time.June, 5, 1, 0, 0, 0, time.UTC), // Wednesday.
+			expected: false,
+		},
+		{
+			name:     "non-UTC time",
+			window:   "mon:02:00-mon:04:00",
+			time:     time.Date(2024, time.June, 2, 23, 0, 0, 0, time.FixedZone("UTC-4", -4*60*60)), // Monday 03:00 UTC.
+			expected: true,
+		},
+		{
+			name:   "invalid",
+			window: "mon:02:00",
+			err:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.InWeeklyMaintenanceWindow(testCase.window, testCase.time)
+
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func TestInstanceHostIDSchema(t *testing.T) {
 	t.Parallel()
 
@@ -6089,6 +6356,50 @@ resource "aws_instance" "test" {
 `, instanceType, rName))
 }
 
+func testAccInstanceConfig_liveResize(rName, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type = %[1]q
+
+  live_resize {
+    wait_for_status_checks = true
+  }
+
+  tags = {
+    Name = %[2]q
+  }
+}
+`, instanceType, rName))
+}
+
+func testAccInstanceConfig_liveResizeMaintenanceWindow(rName, instanceType, window string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type = %[1]q
+
+  live_resize {
+    maintenance_window = %[3]q
+  }
+
+  tags = {
+    Name = %[2]q
+  }
+}
+`, instanceType, rName, window))
+}
+
 func testAccInstanceConfig_typeReplace(rName, instanceType string) string {
 	arch := acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI()
 	archs := "x86_64"
@@ -6117,6 +6428,36 @@ resource "aws_instance" "test" {
 `, instanceType, rName, archs))
 }
 
+func testAccInstanceConfig_liveResizeTypeReplace(rName, instanceType string) string {
+	arch := acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI()
+	archs := "x86_64"
+	if strings.HasPrefix(instanceType, "m6g.") {
+		arch = acctest.ConfigLatestAmazonLinux2HVMEBSARM64AMI()
+		archs = "arm64"
+	}
+	return acctest.ConfigCompose(
+		arch,
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-%[3]s.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type = %[1]q
+
+  live_resize {}
+
+  tags = {
+    Name = %[2]q
+  }
+
+  lifecycle {
+    ignore_changes = [ami]
+  }
+}
+`, instanceType, rName, archs))
+}
+
 func testAccInstanceConfig_typeAndUserData(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -7755,6 +8096,65 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_networkInterfaceENASRDSpecification(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "c6in.xlarge", "m6in.xlarge"),
+		fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id   = aws_subnet.test.id
+  private_ips = ["10.1.1.42"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  network_interface {
+    network_interface_id = aws_network_interface.test.id
+    device_index         = 0
+
+    ena_srd_specification {
+      ena_srd_enabled = true
+
+      ena_srd_udp_specification {
+        ena_srd_udp_enabled = true
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceConfig_prefixCount(rName string, ipv4PrefixCount, ipv6PrefixCount int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCIPv6Config(rName),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t3a.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami               = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type     = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id         = aws_subnet.test.id
+  ipv4_prefix_count = %[2]d
+  ipv6_prefix_count = %[3]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, ipv4PrefixCount, ipv6PrefixCount))
+}
+
 func testAccInstanceConfig_primaryNetworkInterfaceSourceDestCheck(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -66,35 +68,40 @@ func dataSourceInstances() *schema.Resource {
 
 func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 
-	input := &ec2.DescribeInstancesInput{}
+	var filters []awstypes.Filter
 
 	if v, ok := d.GetOk("instance_state_names"); ok && v.(*schema.Set).Len() > 0 {
-		input.Filters = append(input.Filters, awstypes.Filter{
+		filters = append(filters, awstypes.Filter{
 			Name:   aws.String("instance-state-name"),
 			Values: flex.ExpandStringValueSet(v.(*schema.Set)),
 		})
 	} else {
-		input.Filters = append(input.Filters, awstypes.Filter{
+		filters = append(filters, awstypes.Filter{
 			Name:   aws.String("instance-state-name"),
 			Values: enum.Slice(awstypes.InstanceStateNameRunning),
 		})
 	}
 
-	input.Filters = append(input.Filters, newTagFilterList(
+	filters = append(filters, newTagFilterList(
 		Tags(tftags.New(ctx, d.Get("instance_tags").(map[string]interface{}))),
 	)...)
 
-	input.Filters = append(input.Filters, newCustomFilterList(
+	filters = append(filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
 
-	if len(input.Filters) == 0 {
-		input.Filters = nil
-	}
+	// Instances in each requested state are listed concurrently.
+	outputs, err := tfslices.ApplyToAllConcurrentlyWithError(splitFilterList(filters, "instance-state-name"), c.ListConcurrency(ctx), func(filters []awstypes.Filter) ([]awstypes.Instance, error) {
+		input := &ec2.DescribeInstancesInput{
+			Filters:    filters,
+			MaxResults: aws.Int32(1000),
+		}
 
-	output, err := findInstances(ctx, conn, input)
+		return findInstances(ctx, conn, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
@@ -102,7 +109,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	var instanceIDs, privateIPs, publicIPs, ipv6Addresses []string
 
-	for _, v := range output {
+	for _, v := range slices.Concat(outputs...) {
 		instanceIDs = append(instanceIDs, aws.ToString(v.InstanceId))
 		if privateIP := aws.ToString(v.PrivateIpAddress); privateIP != "" {
 			privateIPs = append(privateIPs, privateIP)
@@ -115,7 +122,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(c.Region)
 	d.Set(names.AttrIDs, instanceIDs)
 	d.Set("ipv6_addresses", ipv6Addresses)
 	d.Set("private_ips", privateIPs)
@@ -4,9 +4,12 @@
 package ec2
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -24,7 +27,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -39,7 +44,7 @@ func resourceLaunchTemplate() *schema.Resource {
 		CreateWithoutTimeout: resourceLaunchTemplateCreate,
 		ReadWithoutTimeout:   resourceLaunchTemplateRead,
 		UpdateWithoutTimeout: resourceLaunchTemplateUpdate,
-		DeleteWithoutTimeout: resourceLaunchTemplateDelete,
+		DeleteWithoutTimeout: sdkv2.SkipDestroyDeleteFunc("EC2 Launch Template", resourceLaunchTemplateDelete),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -942,8 +947,9 @@ func resourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrSkipDestroy: sdkv2.SkipDestroySchema(),
+			names.AttrTags:        tftags.TagsSchema(),
+			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 			"update_default_version": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -953,6 +959,11 @@ func resourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"version_retention_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrVPCSecurityGroupIDs: {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -967,7 +978,7 @@ func resourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description":
+					case "name", "name_prefix", "description", "version_retention_count":
 						continue
 					default:
 						return diff.Get("update_default_version").(bool)
@@ -978,7 +989,7 @@ func resourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "update_default_version", "version_retention_count":
 						continue
 					default:
 						return true
@@ -1066,6 +1077,8 @@ func resourceLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, met
 
 	setTagsOut(ctx, lt.Tags)
 
+	sdkv2.SetSkipDestroy(d)
+
 	return diags
 }
 
@@ -1151,9 +1164,73 @@ func resourceLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("version_retention_count"); ok {
+		if err := pruneLaunchTemplateVersions(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning EC2 Launch Template (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceLaunchTemplateRead(ctx, d, meta)...)
 }
 
+// pruneLaunchTemplateVersions deletes all but the newest retain versions of the specified launch template.
+// The default version is never deleted, even if it falls outside of the retained versions.
+func pruneLaunchTemplateVersions(ctx context.Context, conn *ec2.Client, launchTemplateID string, retain int) error {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	versions, err := findLaunchTemplateVersions(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(versions, func(a, b awstypes.LaunchTemplateVersion) int {
+		return cmp.Compare(aws.ToInt64(b.VersionNumber), aws.ToInt64(a.VersionNumber))
+	})
+
+	var prune []string
+	for i, v := range versions {
+		if i < retain || aws.ToBool(v.DefaultVersion) {
+			continue
+		}
+
+		prune = append(prune, flex.Int64ValueToString(aws.ToInt64(v.VersionNumber)))
+	}
+
+	// DeleteLaunchTemplateVersions accepts up to 200 version numbers per request.
+	const (
+		chunkSize = 200
+	)
+	for _, chunk := range tfslices.Chunks(prune, chunkSize) {
+		input := &ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(launchTemplateID),
+			Versions:         chunk,
+		}
+
+		log.Printf("[DEBUG] Deleting EC2 Launch Template (%s) versions: %v", launchTemplateID, chunk)
+		output, err := conn.DeleteLaunchTemplateVersions(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, v := range output.UnsuccessfullyDeletedLaunchTemplateVersions {
+			if err := v.ResponseError; err != nil {
+				errs = append(errs, fmt.Errorf("version %d: %s: %s", aws.ToInt64(v.VersionNumber), string(err.Code), aws.ToString(err.Message)))
+			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
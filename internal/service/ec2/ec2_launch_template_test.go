@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -3265,6 +3267,92 @@ func TestAccEC2LaunchTemplate_updateDefaultVersion(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_versionRetentionCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_versionRetentionCount(rName, "Test Description 1", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_retention_count", acctest.Ct2),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_versionRetentionCount(rName, "Test Description 2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_versionRetentionCount(rName, "Test Description 3", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct3),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 2),
+				),
+			},
+			// Lowering the retention count alone should prune without creating a new version.
+			{
+				Config: testAccLaunchTemplateConfig_versionRetentionCount(rName, "Test Description 3", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "version_retention_count", acctest.Ct1),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"update_default_version",
+					"version_retention_count",
+				},
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_skipDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+			{
+				Config: "# Empty config",
+				Check:  testAccCheckLaunchTemplateRetained(ctx, &template),
+			},
+		},
+	})
+}
+
 func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *awstypes.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -3290,6 +3378,31 @@ func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *awstypes
 	}
 }
 
+func testAccCheckLaunchTemplateVersionCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersions(ctx, conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Launch Template (%s) has %d versions, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckLaunchTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -3316,6 +3429,25 @@ func testAccCheckLaunchTemplateDestroy(ctx context.Context) resource.TestCheckFu
 	}
 }
 
+// testAccCheckLaunchTemplateRetained checks that the EC2 Launch Template still exists after being removed from the configuration and then deletes it.
+func testAccCheckLaunchTemplateRetained(ctx context.Context, v *awstypes.LaunchTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		id := aws.ToString(v.LaunchTemplateId)
+
+		if _, err := tfec2.FindLaunchTemplateByID(ctx, conn, id); err != nil {
+			return fmt.Errorf("EC2 Launch Template %s not retained: %w", id, err)
+		}
+
+		_, err := conn.DeleteLaunchTemplate(ctx, &ec2.DeleteLaunchTemplateInput{
+			LaunchTemplateId: aws.String(id),
+		})
+
+		return err
+	}
+}
+
 func testAccLaunchTemplateConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -3324,6 +3456,15 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_skipDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name         = %[1]q
+  skip_destroy = true
+}
+`, rName)
+}
+
 func testAccLaunchTemplateConfig_nameGenerated() string {
 	return `
 resource "aws_launch_template" "test" {}
@@ -4288,3 +4429,14 @@ resource "aws_launch_template" "test" {
 }
 `, rName, description, update)
 }
+
+func testAccLaunchTemplateConfig_versionRetentionCount(rName, description string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                    = %[1]q
+  description             = %[2]q
+  update_default_version  = true
+  version_retention_count = %[3]d
+}
+`, rName, description, count)
+}
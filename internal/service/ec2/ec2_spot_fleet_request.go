@@ -566,6 +566,12 @@ func resourceSpotFleetRequest() *schema.Resource {
 														ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
 													},
 												},
+												"max_spot_price_as_percentage_of_optimal_on_demand_price": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
 												"memory_gib_per_vcpu": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -1640,6 +1646,10 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.LocalStorageTypes = flex.ExpandStringyValueSet[awstypes.LocalStorageType](v)
 	}
 
+	if v, ok := tfMap["max_spot_price_as_percentage_of_optimal_on_demand_price"].(int); ok && v != 0 {
+		apiObject.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice = aws.Int32(int32(v))
+	}
+
 	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 {
 		apiObject.MemoryGiBPerVCpu = expandMemoryGiBPerVCPU(v[0].(map[string]interface{}))
 	}
@@ -1648,6 +1658,10 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.MemoryMiB = expandMemoryMiB(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NetworkBandwidthGbps = expandNetworkBandwidthGbps(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 {
 		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCount(v[0].(map[string]interface{}))
 	}
@@ -1765,6 +1779,24 @@ func expandMemoryMiB(tfMap map[string]interface{}) *awstypes.MemoryMiB {
 	return apiObject
 }
 
+func expandNetworkBandwidthGbps(tfMap map[string]interface{}) *awstypes.NetworkBandwidthGbps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkBandwidthGbps{}
+
+	if v, ok := tfMap[names.AttrMax].(float64); ok {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	if v, ok := tfMap[names.AttrMin].(float64); ok {
+		apiObject.Min = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
 func expandNetworkInterfaceCount(tfMap map[string]interface{}) *awstypes.NetworkInterfaceCount {
 	if tfMap == nil {
 		return nil
@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_spot_placement_score", name="Spot Placement Score")
+func dataSourceSpotPlacementScore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSpotPlacementScoreRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_requirements_with_metadata": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"instance_requirements_with_metadata", "instance_types"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.ArchitectureType](),
+							},
+						},
+						"instance_requirements": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accelerator_count": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"accelerator_manufacturers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
+										},
+									},
+									"accelerator_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
+										},
+									},
+									"accelerator_total_memory_mib": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"accelerator_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
+										},
+									},
+									"allowed_instance_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 400,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"bare_metal": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
+									},
+									"baseline_ebs_bandwidth_mbps": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"burstable_performance": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
+									},
+									"cpu_manufacturers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
+										},
+									},
+									"excluded_instance_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 400,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"instance_generations": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
+										},
+									},
+									"local_storage": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
+									},
+									"local_storage_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
+										},
+									},
+									"max_spot_price_as_percentage_of_optimal_on_demand_price": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"memory_gib_per_vcpu": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+											},
+										},
+									},
+									"memory_mib": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"network_bandwidth_gbps": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+											},
+										},
+									},
+									"network_interface_count": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"on_demand_max_price_percentage_over_lowest_price": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"require_hibernate_support": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"spot_max_price_percentage_over_lowest_price": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"total_local_storage_gb": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: verify.FloatGreaterThan(0.0),
+												},
+											},
+										},
+									},
+									"vcpu_count": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMax: {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrMin: {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"virtualization_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.VirtualizationType](),
+							},
+						},
+					},
+				},
+			},
+			"instance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"single_availability_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"spot_placement_scores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2000000000),
+			},
+			"target_capacity_unit_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.TargetCapacityUnitType](),
+			},
+		},
+	}
+}
+
+func dataSourceSpotPlacementScoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.GetSpotPlacementScoresInput{
+		SingleAvailabilityZone: aws.Bool(d.Get("single_availability_zone").(bool)),
+		TargetCapacity:         aws.Int32(int32(d.Get("target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("instance_requirements_with_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InstanceRequirementsWithMetadata = expandInstanceRequirementsWithMetadataRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceTypes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("region_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.RegionNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
+		input.TargetCapacityUnitType = awstypes.TargetCapacityUnitType(v.(string))
+	}
+
+	output, err := findSpotPlacementScores(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Placement Scores: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("spot_placement_scores", flattenSpotPlacementScores(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting spot_placement_scores: %s", err)
+	}
+
+	return diags
+}
+
+func expandInstanceRequirementsWithMetadataRequest(tfMap map[string]interface{}) *awstypes.InstanceRequirementsWithMetadataRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.InstanceRequirementsWithMetadataRequest{}
+
+	if v, ok := tfMap["architecture_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ArchitectureTypes = flex.ExpandStringyValueSet[awstypes.ArchitectureType](v)
+	}
+
+	if v, ok := tfMap["instance_requirements"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceRequirements = expandInstanceRequirementsRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["virtualization_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VirtualizationTypes = flex.ExpandStringyValueSet[awstypes.VirtualizationType](v)
+	}
+
+	return apiObject
+}
+
+func flattenSpotPlacementScores(apiObjects []awstypes.SpotPlacementScore) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone_id": aws.ToString(apiObject.AvailabilityZoneId),
+			names.AttrRegion:       aws.ToString(apiObject.Region),
+			"score":                aws.ToInt32(apiObject.Score),
+		})
+	}
+
+	return tfList
+}
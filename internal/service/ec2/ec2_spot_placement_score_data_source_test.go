@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2SpotPlacementScoreDataSource_instanceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_score.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoreDataSourceConfig_instanceTypes(acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "spot_placement_scores.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.availability_zone_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoreDataSource_instanceRequirementsSingleAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_score.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoreDataSourceConfig_instanceRequirements(acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "spot_placement_scores.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoreDataSourceConfig_instanceTypes(region string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_spot_placement_score" "test" {
+  instance_types  = ["m5.large", "m5a.large", "m6i.large"]
+  region_names    = [%[1]q]
+  target_capacity = 2
+}
+`, region)
+}
+
+func testAccSpotPlacementScoreDataSourceConfig_instanceRequirements(region string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_spot_placement_score" "test" {
+  region_names              = [%[1]q]
+  single_availability_zone  = true
+  target_capacity           = 4
+  target_capacity_unit_type = "vcpu"
+
+  instance_requirements_with_metadata {
+    architecture_types   = ["x86_64"]
+    virtualization_types = ["hvm"]
+
+    instance_requirements {
+      memory_mib {
+        min = 4096
+      }
+
+      vcpu_count {
+        min = 2
+        max = 4
+      }
+    }
+  }
+}
+`, region)
+}
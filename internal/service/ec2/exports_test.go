@@ -8,6 +8,7 @@ var (
 	ResourceAMICopy                                       = resourceAMICopy
 	ResourceAMIFromInstance                               = resourceAMIFromInstance
 	ResourceAMILaunchPermission                           = resourceAMILaunchPermission
+	ResourceAddressTransfer                               = newAddressTransferResource
 	ResourceAvailabilityZoneGroup                         = resourceAvailabilityZoneGroup
 	ResourceCapacityReservation                           = resourceCapacityReservation
 	ResourceCarrierGateway                                = resourceCarrierGateway
@@ -124,7 +125,9 @@ var (
 	CustomerGatewayConfigurationToTunnelInfo                   = customerGatewayConfigurationToTunnelInfo
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
+	ExcludeDeprecatedImages                                    = excludeDeprecatedImages
 	ExpandIPPerms                                              = expandIPPerms
+	FindAddressTransferByAllocationID                          = findAddressTransferByAllocationID
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
@@ -139,6 +142,7 @@ var (
 	FindEBSVolumeByID                                          = findEBSVolumeByID
 	FindEIPByAllocationID                                      = findEIPByAllocationID
 	FindEIPByAssociationID                                     = findEIPByAssociationID
+	FindEIPByPublicIP                                          = findEIPByPublicIP
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
 	FindEgressOnlyInternetGatewayByID                          = findEgressOnlyInternetGatewayByID
 	FindFastSnapshotRestoreByTwoPartKey                        = findFastSnapshotRestoreByTwoPartKey
@@ -161,6 +165,7 @@ var (
 	FindInternetGatewayByID                                    = findInternetGatewayByID
 	FindKeyPairByName                                          = findKeyPairByName
 	FindLaunchTemplateByID                                     = findLaunchTemplateByID
+	FindLaunchTemplateVersions                                 = findLaunchTemplateVersions
 	FindLocalGatewayRouteByTwoPartKey                          = findLocalGatewayRouteByTwoPartKey
 	FindLocalGatewayRouteTableVPCAssociationByID               = findLocalGatewayRouteTableVPCAssociationByID
 	FindMainRouteTableAssociationByID                          = findMainRouteTableAssociationByID
@@ -185,6 +190,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRuleIDsBySecurityGroupID                  = findSecurityGroupRuleIDsBySecurityGroupID
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
@@ -240,6 +246,7 @@ var (
 	FlattenNetworkInterfacePrivateIPAddresses                  = flattenNetworkInterfacePrivateIPAddresses
 	FlattenSecurityGroups                                      = flattenSecurityGroups
 	IPAMServicePrincipal                                       = ipamServicePrincipal
+	InWeeklyMaintenanceWindow                                  = inWeeklyMaintenanceWindow
 	InstanceMigrateState                                       = instanceMigrateState
 	InternetGatewayAttachmentParseResourceID                   = internetGatewayAttachmentParseResourceID
 	KeyPairMigrateState                                        = keyPairMigrateState
@@ -261,6 +268,7 @@ var (
 	SecurityGroupRuleCreateID                                  = securityGroupRuleCreateID
 	SecurityGroupRuleHash                                      = securityGroupRuleHash
 	SecurityGroupRuleMigrateState                              = securityGroupRuleMigrateState
+	SplitFilterList                                            = splitFilterList
 	SpotFleetRequestMigrateState                               = spotFleetRequestMigrateState
 	StopEBSVolumeAttachmentInstance                            = stopVolumeAttachmentInstance
 	StopInstance                                               = stopInstance
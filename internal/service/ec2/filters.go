@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"slices"
 	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -250,3 +251,27 @@ func newAttributeFilterList(m map[string]string) []awstypes.Filter {
 
 	return filters
 }
+
+// splitFilterList splits a []awstypes.Filter on the values of the first filter with the given name.
+// One filter list is returned for each of the filter's values, so that the resources matching each value can be
+// listed concurrently. The name must be that of an attribute with a single value per resource, such as "vpc-id",
+// so that no resource is listed more than once.
+// The filters are returned unchanged if the named filter is not present or has a single value.
+func splitFilterList(filters []awstypes.Filter, name string) [][]awstypes.Filter {
+	i := slices.IndexFunc(filters, func(v awstypes.Filter) bool {
+		return aws.ToString(v.Name) == name
+	})
+
+	if i == -1 || len(filters[i].Values) < 2 {
+		return [][]awstypes.Filter{filters}
+	}
+
+	output := make([][]awstypes.Filter, 0, len(filters[i].Values))
+	for _, value := range filters[i].Values {
+		v := slices.Clone(filters)
+		v[i] = newFilter(name, []string{value})
+		output = append(output, v)
+	}
+
+	return output
+}
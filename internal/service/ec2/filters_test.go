@@ -156,3 +156,82 @@ func TestNewCustomFilterList(t *testing.T) {
 		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestSplitFilterList(t *testing.T) {
+	t.Parallel()
+
+	tagFilter := awstypes.Filter{
+		Name:   aws.String("tag:Name"),
+		Values: []string{"test"},
+	}
+
+	testCases := map[string]struct {
+		filters  []awstypes.Filter
+		expected [][]awstypes.Filter
+	}{
+		"no filters": {
+			expected: [][]awstypes.Filter{nil},
+		},
+		"filter not present": {
+			filters:  []awstypes.Filter{tagFilter},
+			expected: [][]awstypes.Filter{{tagFilter}},
+		},
+		"single value": {
+			filters: []awstypes.Filter{
+				{
+					Name:   aws.String("vpc-id"),
+					Values: []string{"vpc-1"},
+				},
+				tagFilter,
+			},
+			expected: [][]awstypes.Filter{
+				{
+					{
+						Name:   aws.String("vpc-id"),
+						Values: []string{"vpc-1"},
+					},
+					tagFilter,
+				},
+			},
+		},
+		"multiple values": {
+			filters: []awstypes.Filter{
+				tagFilter,
+				{
+					Name:   aws.String("vpc-id"),
+					Values: []string{"vpc-1", "vpc-2"},
+				},
+			},
+			expected: [][]awstypes.Filter{
+				{
+					tagFilter,
+					{
+						Name:   aws.String("vpc-id"),
+						Values: []string{"vpc-1"},
+					},
+				},
+				{
+					tagFilter,
+					{
+						Name:   aws.String("vpc-id"),
+						Values: []string{"vpc-2"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.SplitFilterList(testCase.filters, "vpc-id")
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(awstypes.Filter{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
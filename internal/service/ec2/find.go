@@ -1232,6 +1232,23 @@ func findSpotInstanceRequestByID(ctx context.Context, conn *ec2.Client, id strin
 	return output, nil
 }
 
+func findSpotPlacementScores(ctx context.Context, conn *ec2.Client, input *ec2.GetSpotPlacementScoresInput) ([]awstypes.SpotPlacementScore, error) {
+	var output []awstypes.SpotPlacementScore
+	pages := ec2.NewGetSpotPlacementScoresPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SpotPlacementScores...)
+	}
+
+	return output, nil
+}
+
 func findSpotPrices(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpotPriceHistoryInput) ([]awstypes.SpotPrice, error) {
 	var output []awstypes.SpotPrice
 	pages := ec2.NewDescribeSpotPriceHistoryPaginator(conn, input)
@@ -2060,6 +2077,25 @@ func findSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.Clie
 	return findSecurityGroupRules(ctx, conn, input)
 }
 
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	output, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []string
+	for _, v := range output {
+		if aws.ToBool(v.IsEgress) {
+			egress = append(egress, aws.ToString(v.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.ToString(v.SecurityGroupRuleId))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
 func findNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) ([]awstypes.NetworkInterface, error) {
 	var output []awstypes.NetworkInterface
 
@@ -3942,6 +3978,60 @@ func findIPAMPoolCIDRByTwoPartKey(ctx context.Context, conn *ec2.Client, cidrBlo
 	return output, nil
 }
 
+func findByoipCIDRs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeByoipCidrsInput) ([]awstypes.ByoipCidr, error) {
+	var output []awstypes.ByoipCidr
+
+	pages := ec2.NewDescribeByoipCidrsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ByoipCidrs...)
+	}
+
+	return output, nil
+}
+
+func findIPAMBYOASNAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, asn, cidrBlock string) (*awstypes.AsnAssociation, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	output, err := findByoipCIDRs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, byoipCIDR := range output {
+		if aws.ToString(byoipCIDR.Cidr) != cidrBlock {
+			continue
+		}
+
+		for _, v := range byoipCIDR.AsnAssociations {
+			if aws.ToString(v.Asn) != asn {
+				continue
+			}
+
+			if state := v.State; state == awstypes.AsnAssociationStateDisassociated {
+				return nil, &retry.NotFoundError{
+					Message:     string(state),
+					LastRequest: input,
+				}
+			}
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func findIPAMPoolCIDRByPoolCIDRIDAndPoolID(ctx context.Context, conn *ec2.Client, poolCIDRID, poolID string) (*awstypes.IpamPoolCidr, error) {
 	input := &ec2.GetIpamPoolCidrsInput{
 		Filters: newAttributeFilterList(map[string]string{
@@ -5377,6 +5467,27 @@ func findEIPByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*a
 	return output, nil
 }
 
+func findEIPByPublicIP(ctx context.Context, conn *ec2.Client, ip string) (*awstypes.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		PublicIps: []string{ip},
+	}
+
+	output, err := findEIP(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.PublicIp) != ip {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findEIPByAssociationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Address, error) {
 	input := &ec2.DescribeAddressesInput{
 		Filters: newAttributeFilterList(map[string]string{
@@ -5400,6 +5511,68 @@ func findEIPByAssociationID(ctx context.Context, conn *ec2.Client, id string) (*
 	return output, nil
 }
 
+func findAddressTransfers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) ([]awstypes.AddressTransfer, error) {
+	var output []awstypes.AddressTransfer
+
+	pages := ec2.NewDescribeAddressTransfersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AddressTransfers...)
+	}
+
+	return output, nil
+}
+
+func findAddressTransfer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) (*awstypes.AddressTransfer, error) {
+	output, err := findAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findAddressTransferByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: []string{id},
+	}
+
+	output, err := findAddressTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AddressTransferStatus; status == awstypes.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findEIPAttributes(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressesAttributeInput) ([]awstypes.AddressAttribute, error) {
 	var output []awstypes.AddressAttribute
 
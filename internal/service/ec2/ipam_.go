@@ -227,6 +227,7 @@ func resourceIPAMUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		if d.HasChange("tier") {
 			input.Tier = awstypes.IpamTier(d.Get("tier").(string))
+			diags = append(diags, ipamTierChangeDiagnostic(input.Tier))
 		}
 
 		_, err := conn.ModifyIpam(ctx, input)
@@ -273,6 +274,24 @@ func resourceIPAMDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// ipamTierChangeDiagnostic returns a warning describing the billing and feature impact of switching IPAM tier.
+func ipamTierChangeDiagnostic(tier awstypes.IpamTier) diag.Diagnostic {
+	var detail string
+
+	switch tier {
+	case awstypes.IpamTierAdvanced:
+		detail = "The Advanced Tier is billed per active IP address managed by IPAM in all operating Regions. See https://aws.amazon.com/vpc/pricing/ for current prices."
+	case awstypes.IpamTierFree:
+		detail = "The Free Tier only supports public IP insights and public IP address management. Private scopes and pools, and monitoring of private IP address space, are no longer available."
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("IPAM tier changed to %q", tier),
+		Detail:   detail,
+	}
+}
+
 func expandIPAMOperatingRegions(operatingRegions []interface{}) []awstypes.AddIpamOperatingRegion {
 	regions := make([]awstypes.AddIpamOperatingRegion, 0, len(operatingRegions))
 	for _, regionRaw := range operatingRegions {
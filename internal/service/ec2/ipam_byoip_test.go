@@ -164,6 +164,47 @@ func TestAccIPAM_byoipIPv6(t *testing.T) {
 	})
 }
 
+// IPAM BYOASN Tests
+// The ASN must already be provisioned to the IPAM that owns the public scope.
+func TestAccIPAM_byoasn(t *testing.T) {
+	ctx := acctest.Context(t)
+	keys := []string{"IPAM_BYOASN_ASN", "IPAM_BYOASN_PUBLIC_SCOPE_ID", "IPAM_BYOIP_IPV4_PROVISIONED_CIDR", "IPAM_BYOIP_IPV4_MESSAGE", "IPAM_BYOIP_IPV4_SIGNATURE"}
+	for _, k := range keys {
+		if os.Getenv(k) == "" {
+			t.Skipf("Environment variable %s is not set", k)
+		}
+	}
+
+	asn := os.Getenv("IPAM_BYOASN_ASN")
+	scopeID := os.Getenv("IPAM_BYOASN_PUBLIC_SCOPE_ID")
+	p := os.Getenv("IPAM_BYOIP_IPV4_PROVISIONED_CIDR")
+	m := os.Getenv("IPAM_BYOIP_IPV4_MESSAGE")
+	s := os.Getenv("IPAM_BYOIP_IPV4_SIGNATURE")
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMPoolCIDRDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMBYOIPConfig_byoasn(scopeID, p, m, s, asn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "asn", asn),
+					resource.TestCheckResourceAttr(resourceName, "cidr", p),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asn", "cidr_authorization_context"},
+			},
+		},
+	})
+}
+
 func testAccIPAMConfig_ipv6BYOIPSkipExplicitCIDR(t *testing.T, ipv6CidrVPC string) func() (bool, error) {
 	return func() (bool, error) {
 		if ipv6CidrVPC != "" {
@@ -458,3 +499,28 @@ resource "aws_vpc_ipv6_cidr_block_association" "test" {
 }
 	`, cidr, msg, signature, vpcCidr)
 }
+
+func testAccIPAMBYOIPConfig_byoasn(scopeID, cidr, msg, signature, asn string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family        = "ipv4"
+  ipam_scope_id         = %[1]q
+  locale                = data.aws_region.current.name
+  publicly_advertisable = true
+  aws_service           = "ec2"
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[2]q
+  asn          = %[5]q
+
+  cidr_authorization_context {
+    message   = %[3]q
+    signature = %[4]q
+  }
+}
+`, scopeID, cidr, msg, signature, asn)
+}
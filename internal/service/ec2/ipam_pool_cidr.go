@@ -47,6 +47,12 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.Valid4ByteASN,
+			},
 			"cidr": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,6 +153,25 @@ func resourceIPAMPoolCIDRCreate(ctx context.Context, d *schema.ResourceData, met
 	// ipam_pool_cidr_id was not part of the initial feature release
 	d.SetId(ipamPoolCIDRCreateResourceID(aws.ToString(ipamPoolCidr.Cidr), poolID))
 
+	if v, ok := d.GetOk("asn"); ok {
+		asn := v.(string)
+		cidrBlock := aws.ToString(ipamPoolCidr.Cidr)
+		input := &ec2.AssociateIpamByoasnInput{
+			Asn:  aws.String(asn),
+			Cidr: aws.String(cidrBlock),
+		}
+
+		_, err := conn.AssociateIpamByoasn(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "associating IPAM BYOASN (%s) with IPAM Pool CIDR (%s): %s", asn, d.Id(), err)
+		}
+
+		if _, err := waitIPAMBYOASNAssociated(ctx, conn, asn, cidrBlock, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN (%s) association with IPAM Pool CIDR (%s): %s", asn, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceIPAMPoolCIDRRead(ctx, d, meta)...)
 }
 
@@ -175,6 +200,18 @@ func resourceIPAMPoolCIDRRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("ipam_pool_cidr_id", output.IpamPoolCidrId)
 	d.Set("ipam_pool_id", poolID)
 
+	if v, ok := d.GetOk("asn"); ok {
+		asn := v.(string)
+		_, err := findIPAMBYOASNAssociationByTwoPartKey(ctx, conn, asn, cidrBlock)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("asn", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading IPAM BYOASN (%s) association with IPAM Pool CIDR (%s): %s", asn, d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -187,6 +224,24 @@ func resourceIPAMPoolCIDRDelete(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if v, ok := d.GetOk("asn"); ok {
+		asn := v.(string)
+
+		log.Printf("[DEBUG] Disassociating IPAM BYOASN (%s) from IPAM Pool CIDR: %s", asn, d.Id())
+		_, err := conn.DisassociateIpamByoasn(ctx, &ec2.DisassociateIpamByoasnInput{
+			Asn:  aws.String(asn),
+			Cidr: aws.String(cidrBlock),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeIncorrectState) {
+			return sdkdiag.AppendErrorf(diags, "disassociating IPAM BYOASN (%s) from IPAM Pool CIDR (%s): %s", asn, d.Id(), err)
+		}
+
+		if _, err := waitIPAMBYOASNDisassociated(ctx, conn, asn, cidrBlock, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN (%s) disassociation from IPAM Pool CIDR (%s): %s", asn, d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting IPAM Pool CIDR: %s", d.Id())
 	_, err = conn.DeprovisionIpamPoolCidr(ctx, &ec2.DeprovisionIpamPoolCidrInput{
 		Cidr:       aws.String(cidrBlock),
@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newCapacityBlockOfferingDataSource,
 			Name:    "Capacity Block Offering",
 		},
+		{
+			Factory: newInstanceConnectEndpointDataSource,
+			Name:    "Instance Connect Endpoint",
+		},
 		{
 			Factory: newSecurityGroupRuleDataSource,
 			Name:    "Security Group Rule",
@@ -31,6 +35,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAddressTransferAccepterResource,
+			Name:    "Address Transfer Accepter",
+		},
+		{
+			Factory: newAddressTransferResource,
+			Name:    "Address Transfer",
+		},
 		{
 			Factory: newCapacityBlockReservationResource,
 			Name:    "Capacity Block Reservation",
@@ -71,6 +83,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupRulesExclusiveResource,
+			Name:    "Security Group Rules Exclusive",
+		},
 		{
 			Factory: newVPCEndpointPrivateDNSResource,
 			Name:    "VPC Endpoint Private DNS",
@@ -166,6 +182,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Host",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceHosts,
+			TypeName: "aws_ec2_hosts",
+			Name:     "Hosts",
+		},
 		{
 			Factory:  dataSourceInstanceType,
 			TypeName: "aws_ec2_instance_type",
@@ -262,6 +283,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_serial_console_access",
 			Name:     "Serial Console Access",
 		},
+		{
+			Factory:  dataSourceSpotPlacementScore,
+			TypeName: "aws_ec2_spot_placement_score",
+			Name:     "Spot Placement Score",
+		},
 		{
 			Factory:  dataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
@@ -142,6 +142,33 @@ func statusInstanceIAMInstanceProfile(ctx context.Context, conn *ec2.Client, id
 	}
 }
 
+// statusInstanceStatusChecks returns the combined result of an instance's instance and system status checks.
+func statusInstanceStatusChecks(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInstanceStatus(ctx, conn, &ec2.DescribeInstanceStatusInput{
+			InstanceIds: []string{id},
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.InstanceStatus == nil || output.SystemStatus == nil {
+			return output, string(awstypes.SummaryStatusInitializing), nil
+		}
+
+		if status := output.SystemStatus.Status; status != awstypes.SummaryStatusOk {
+			return output, string(status), nil
+		}
+
+		return output, string(output.InstanceStatus.Status), nil
+	}
+}
+
 func statusInstanceCapacityReservationSpecificationEquals(ctx context.Context, conn *ec2.Client, id string, expectedValue *awstypes.CapacityReservationSpecification) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findInstanceByID(ctx, conn, id)
@@ -1069,6 +1096,22 @@ func statusIPAMPool(ctx context.Context, conn *ec2.Client, id string) retry.Stat
 	}
 }
 
+func statusIPAMBYOASNAssociation(ctx context.Context, conn *ec2.Client, asn, cidrBlock string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIPAMBYOASNAssociationByTwoPartKey(ctx, conn, asn, cidrBlock)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func statusIPAMPoolCIDR(ctx context.Context, conn *ec2.Client, cidrBlock, poolID, poolCIDRID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		if cidrBlock == "" {
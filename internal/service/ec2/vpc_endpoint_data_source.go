@@ -128,6 +128,26 @@ func dataSourceVPCEndpoint() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"subnet_configuration": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrSubnetIDs: {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -234,6 +254,16 @@ func dataSourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("prefix_list_id", pl.PrefixListId)
 	}
 
+	subnetConfigurations, err := findSubnetConfigurationsByNetworkInterfaceIDs(ctx, conn, vpce.NetworkInterfaceIds)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint (%s) subnet configurations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("subnet_configuration", flattenSubnetConfigurations(subnetConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting subnet_configuration: %s", err)
+	}
+
 	policy, err := structure.NormalizeJsonString(aws.ToString(vpce.PolicyDocument))
 
 	if err != nil {
@@ -243,6 +243,7 @@ func TestAccVPCEndpointDataSource_interface(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "security_group_ids.#", resourceName, "security_group_ids.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, names.AttrServiceName, resourceName, names.AttrServiceName),
 					resource.TestCheckResourceAttrPair(datasourceName, names.AttrState, resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(datasourceName, "subnet_configuration.#", resourceName, "subnet_configuration.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
 					resource.TestCheckResourceAttrPair(datasourceName, "vpc_endpoint_type", resourceName, "vpc_endpoint_type"),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,12 +16,14 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -110,10 +113,23 @@ func resourceFlowLog() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.LogDestinationType](),
 			},
 			"log_format": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"log_format_fields"},
+			},
+			"log_format_fields": {
+				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
 				Computed: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(append(flowLogVPCFields, flowLogTransitGatewayFields...), false),
+				},
+				ConflictsWith: []string{"log_format"},
 			},
 			names.AttrLogGroupName: {
 				Type:          schema.TypeString,
@@ -164,10 +180,59 @@ func resourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceLogFlowCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// Fields supported in custom flow log record formats.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/flow-log-records.html#flow-logs-fields
+// and https://docs.aws.amazon.com/vpc/latest/tgw/tgw-flow-logs.html#flow-log-records.
+var (
+	flowLogVPCFields = []string{
+		"version", "account-id", "interface-id", "srcaddr", "dstaddr", "srcport", "dstport", "protocol", "packets", "bytes", "start", "end", "action", "log-status",
+		"vpc-id", "subnet-id", "instance-id", "tcp-flags", "type", "pkt-srcaddr", "pkt-dstaddr",
+		"region", "az-id", "sublocation-type", "sublocation-id",
+		"pkt-src-aws-service", "pkt-dst-aws-service", "flow-direction", "traffic-path",
+		"ecs-cluster-arn", "ecs-cluster-name", "ecs-container-instance-arn", "ecs-container-instance-id", "ecs-container-id", "ecs-second-container-id", "ecs-service-name", "ecs-task-definition-arn", "ecs-task-arn", "ecs-task-id",
+	}
+	flowLogTransitGatewayFields = []string{
+		"version", "resource-type", "account-id", "tgw-id", "tgw-attachment-id", "tgw-src-vpc-account-id", "tgw-dst-vpc-account-id", "tgw-src-vpc-id", "tgw-dst-vpc-id",
+		"tgw-src-subnet-id", "tgw-dst-subnet-id", "tgw-src-eni", "tgw-dst-eni", "tgw-src-az-id", "tgw-dst-az-id", "tgw-pair-attachment-id",
+		"srcaddr", "dstaddr", "srcport", "dstport", "protocol", "packets", "bytes", "start", "end", "log-status", "type",
+		"packets-lost-no-route", "packets-lost-blackhole", "packets-lost-mtu-exceeded", "packets-lost-ttl-expired", "tcp-flags", "region", "flow-direction", "pkt-src-aws-service", "pkt-dst-aws-service",
+	}
+)
+
+func resourceLogFlowCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	supportedFields, resourceType := flowLogVPCFields, "VPC"
+
+	for _, key := range []string{names.AttrTransitGatewayID, names.AttrTransitGatewayAttachmentID} {
+		if v := diff.GetRawConfig().GetAttr(key); v.IsNull() {
+			continue
+		}
+
+		supportedFields, resourceType = flowLogTransitGatewayFields, "Transit Gateway"
+
+		// Transit Gateway flow logs only support a 1 minute aggregation interval.
+		if v := diff.Get("max_aggregation_interval").(int); v != 60 {
+			return fmt.Errorf("max_aggregation_interval must be 60 for Transit Gateway flow logs, got %d", v)
+		}
+	}
+
+	if v := diff.GetRawConfig().GetAttr("log_format_fields"); v.IsKnown() && !v.IsNull() {
+		for _, field := range diff.Get("log_format_fields").([]interface{}) {
+			if field, ok := field.(string); ok && field != "" && !slices.Contains(supportedFields, field) {
+				return fmt.Errorf("log_format_fields: %q is not supported in %s flow logs", field, resourceType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -240,6 +305,10 @@ func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.LogFormat = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("log_format_fields"); ok && len(v.([]interface{})) > 0 {
+		input.LogFormat = aws.String(flowLogFormatFromFields(flex.ExpandStringValueList(v.([]interface{}))))
+	}
+
 	if v, ok := d.GetOk(names.AttrLogGroupName); ok {
 		input.LogGroupName = aws.String(v.(string))
 	}
@@ -301,6 +370,7 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("log_destination", fl.LogDestination)
 	d.Set("log_destination_type", fl.LogDestinationType)
 	d.Set("log_format", fl.LogFormat)
+	d.Set("log_format_fields", flowLogFieldsFromFormat(aws.ToString(fl.LogFormat)))
 	d.Set(names.AttrLogGroupName, fl.LogGroupName)
 	d.Set("max_aggregation_interval", fl.MaxAggregationInterval)
 	switch resourceID := aws.ToString(fl.ResourceId); {
@@ -395,3 +465,23 @@ func flattenDestinationOptionsResponse(apiObject *awstypes.DestinationOptionsRes
 
 	return tfMap
 }
+
+func flowLogFormatFromFields(fields []string) string {
+	format := make([]string, len(fields))
+
+	for i, field := range fields {
+		format[i] = fmt.Sprintf("${%s}", field)
+	}
+
+	return strings.Join(format, " ")
+}
+
+func flowLogFieldsFromFormat(format string) []string {
+	var fields []string
+
+	for _, v := range strings.Fields(format) {
+		fields = append(fields, strings.TrimSuffix(strings.TrimPrefix(v, "${"), "}"))
+	}
+
+	return fields
+}
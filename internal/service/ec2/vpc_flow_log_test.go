@@ -78,6 +78,10 @@ func TestAccVPCFlowLog_logFormat(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFlowLogExists(ctx, resourceName, &flowLog),
 					resource.TestCheckResourceAttr(resourceName, "log_format", logFormat),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.0", names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.1", "vpc-id"),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.2", "subnet-id"),
 				),
 			},
 			{
@@ -89,6 +93,54 @@ func TestAccVPCFlowLog_logFormat(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_logFormatFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flowLog awstypes.FlowLog
+	resourceName := "aws_flow_log.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCFlowLogConfig_formatFields(rName, `"version", "srcaddr", "dstaddr", "flow-direction", "traffic-path"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(ctx, resourceName, &flowLog),
+					resource.TestCheckResourceAttr(resourceName, "log_format", "${version} ${srcaddr} ${dstaddr} ${flow-direction} ${traffic-path}"),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "log_format_fields.3", "flow-direction"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCFlowLog_LogFormatFields_unsupported(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_formatFields(rName, `"version", "tgw-id"`),
+				ExpectError: regexache.MustCompile(`"tgw-id" is not supported in VPC flow logs`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_subnetID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog awstypes.FlowLog
@@ -163,6 +215,24 @@ func TestAccVPCFlowLog_transitGatewayID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_TransitGatewayID_invalidMaxAggregationInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName, 600),
+				ExpectError: regexache.MustCompile(`max_aggregation_interval must be 60 for Transit Gateway flow logs`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog awstypes.FlowLog
@@ -966,6 +1036,27 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_formatFields(rName, fields string) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = aws_s3_bucket.test.arn
+  log_destination_type = "s3"
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+  log_format_fields    = [%[2]s]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, fields))
+}
+
 func testAccVPCFlowLogConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -1151,6 +1242,32 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName string, interval int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_s3_bucket.test.arn
+  log_destination_type     = "s3"
+  max_aggregation_interval = %[2]d
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, interval)
+}
+
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -90,7 +90,6 @@ func resourceNATGateway() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"secondary_private_ip_addresses"},
 			},
 			"secondary_private_ip_addresses": {
@@ -224,7 +223,51 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	switch d.Get("connectivity_type").(string) {
 	case string(awstypes.ConnectivityTypePrivate):
-		if d.HasChanges("secondary_private_ip_addresses") {
+		if d.HasChange("secondary_private_ip_address_count") && !d.GetRawConfig().GetAttr("secondary_private_ip_address_count").IsNull() {
+			o, n := d.GetChange("secondary_private_ip_address_count")
+
+			if delta := n.(int) - o.(int); delta > 0 {
+				input := &ec2.AssignPrivateNatGatewayAddressInput{
+					NatGatewayId:          aws.String(d.Id()),
+					PrivateIpAddressCount: aws.Int32(int32(delta)),
+				}
+
+				output, err := conn.AssignPrivateNatGatewayAddress(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, v := range output.NatGatewayAddresses {
+					privateIP := aws.ToString(v.PrivateIp)
+					if _, err := waitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+					}
+				}
+			} else if delta < 0 {
+				oRaw, _ := d.GetChange("secondary_private_ip_addresses")
+				privateIPs := flex.ExpandStringValueSet(oRaw.(*schema.Set))
+				// Any secondary address may be released, so drop the surplus from the end of the current set.
+				del := privateIPs[len(privateIPs)+delta:]
+
+				input := &ec2.UnassignPrivateNatGatewayAddressInput{
+					NatGatewayId:       aws.String(d.Id()),
+					PrivateIpAddresses: del,
+				}
+
+				_, err := conn.UnassignPrivateNatGatewayAddress(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "unassigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, privateIP := range del {
+					if _, err := waitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %s", d.Id(), privateIP, err)
+					}
+				}
+			}
+		} else if d.HasChanges("secondary_private_ip_addresses") {
 			oRaw, nRaw := d.GetChange("secondary_private_ip_addresses")
 			o, n := oRaw.(*schema.Set), nRaw.(*schema.Set)
 
@@ -375,6 +418,22 @@ func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 			return fmt.Errorf(`secondary_allocation_ids is not supported with connectivity_type = "%s"`, connectivityType)
 		}
 
+		if diff.Id() != "" && diff.HasChange("secondary_private_ip_address_count") {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_addresses to computed: %s", err)
+				}
+			}
+		}
+
+		if diff.Id() != "" && diff.HasChange("secondary_private_ip_addresses") {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_addresses"); v.IsKnown() && !v.IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_address_count to computed: %s", err)
+				}
+			}
+		}
+
 	case string(awstypes.ConnectivityTypePublic):
 		if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
 			return fmt.Errorf(`secondary_private_ip_address_count is not supported with connectivity_type = "%s"`, connectivityType)
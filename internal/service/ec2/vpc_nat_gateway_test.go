@@ -5,10 +5,12 @@ package ec2_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -252,7 +254,7 @@ func TestAccVPCNATGateway_secondaryAllocationIDs(t *testing.T) {
 
 func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
 	ctx := acctest.Context(t)
-	var natGateway awstypes.NatGateway
+	var natGateway1, natGateway2, natGateway3 awstypes.NatGateway
 	resourceName := "aws_nat_gateway.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -267,7 +269,7 @@ func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
 			{
 				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, secondaryPrivateIpAddressCount),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway1),
 					resource.TestCheckResourceAttr(resourceName, "secondary_allocation_ids.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", strconv.Itoa(secondaryPrivateIpAddressCount)),
 					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", strconv.Itoa(secondaryPrivateIpAddressCount)),
@@ -278,6 +280,24 @@ func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, secondaryPrivateIpAddressCount+2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway2),
+					testAccCheckNATGatewayNotRecreated(&natGateway1, &natGateway2),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", strconv.Itoa(secondaryPrivateIpAddressCount+2)),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", strconv.Itoa(secondaryPrivateIpAddressCount+2)),
+				),
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, secondaryPrivateIpAddressCount-2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway3),
+					testAccCheckNATGatewayNotRecreated(&natGateway2, &natGateway3),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", strconv.Itoa(secondaryPrivateIpAddressCount-2)),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", strconv.Itoa(secondaryPrivateIpAddressCount-2)),
+				),
+			},
 		},
 	})
 }
@@ -450,6 +470,16 @@ func testAccCheckNATGatewayExists(ctx context.Context, n string, v *awstypes.Nat
 	}
 }
 
+func testAccCheckNATGatewayNotRecreated(i, j *awstypes.NatGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.NatGatewayId) != aws.ToString(j.NatGatewayId) {
+			return errors.New("EC2 NAT Gateway was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccNATGatewayConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,7 +41,7 @@ func resourceNetworkInterface() *schema.Resource {
 		CreateWithoutTimeout: resourceNetworkInterfaceCreate,
 		ReadWithoutTimeout:   resourceNetworkInterfaceRead,
 		UpdateWithoutTimeout: resourceNetworkInterfaceUpdate,
-		DeleteWithoutTimeout: resourceNetworkInterfaceDelete,
+		DeleteWithoutTimeout: sdkv2.SkipDestroyDeleteFunc("Network Interface", resourceNetworkInterfaceDelete),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -76,6 +77,34 @@ func resourceNetworkInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ena_srd_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ena_srd_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"ena_srd_udp_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_udp_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"interface_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -205,8 +234,9 @@ func resourceNetworkInterface() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrSkipDestroy: sdkv2.SkipDestroySchema(),
+			names.AttrTags:        tftags.TagsSchema(),
+			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -484,6 +514,13 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		// ENA Express is configured on the attachment.
+		if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := modifyNetworkInterfaceENASRDSpecification(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	return append(diags, resourceNetworkInterfaceRead(ctx, d, meta)...)
@@ -526,6 +563,12 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("attachment", nil)
 	}
 	d.Set(names.AttrDescription, eni.Description)
+	// ENA Express settings are only reported while the network interface is attached.
+	if eni.Attachment != nil && eni.Attachment.EnaSrdSpecification != nil {
+		if err := d.Set("ena_srd_specification", []interface{}{flattenAttachmentENASRDSpecification(eni.Attachment.EnaSrdSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+		}
+	}
 	d.Set("interface_type", eni.InterfaceType)
 	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
@@ -562,6 +605,8 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 
 	setTagsOut(ctx, eni.TagSet)
 
+	sdkv2.SetSkipDestroy(d)
+
 	return diags
 }
 
@@ -590,6 +635,14 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChanges("attachment", "ena_srd_specification") {
+		if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && d.Get("attachment").(*schema.Set).Len() > 0 {
+			if err := modifyNetworkInterfaceENASRDSpecification(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.HasChange("private_ips") && !d.Get("private_ip_list_enabled").(bool) {
 		o, n := d.GetChange("private_ips")
 		if o == nil {
@@ -1076,6 +1129,21 @@ func attachNetworkInterface(ctx context.Context, conn *ec2.Client, networkInterf
 	return attachmentID, nil
 }
 
+func modifyNetworkInterfaceENASRDSpecification(ctx context.Context, conn *ec2.Client, networkInterfaceID string, tfMap map[string]interface{}) error {
+	input := &ec2.ModifyNetworkInterfaceAttributeInput{
+		EnaSrdSpecification: expandENASRDSpecification(tfMap),
+		NetworkInterfaceId:  aws.String(networkInterfaceID),
+	}
+
+	_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying EC2 Network Interface (%s) EnaSrdSpecification: %w", networkInterfaceID, err)
+	}
+
+	return nil
+}
+
 func deleteNetworkInterface(ctx context.Context, conn *ec2.Client, networkInterfaceID string) error {
 	log.Printf("[INFO] Deleting EC2 Network Interface: %s", networkInterfaceID)
 	_, err := conn.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
@@ -1181,6 +1249,47 @@ func flattenNetworkInterfaceAttachment(apiObject *types.NetworkInterfaceAttachme
 	return tfMap
 }
 
+func expandENASRDSpecification(tfMap map[string]interface{}) *types.EnaSrdSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EnaSrdSpecification{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EnaSrdUdpSpecification = &types.EnaSrdUdpSpecification{}
+
+		if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+			apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAttachmentENASRDSpecification(apiObject *types.AttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ena_srd_enabled": aws.ToBool(apiObject.EnaSrdEnabled),
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []interface{}{map[string]interface{}{
+			"ena_srd_udp_enabled": aws.ToBool(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
+
 func expandPrivateIPAddressSpecification(tfString string) *types.PrivateIpAddressSpecification {
 	if tfString == "" {
 		return nil
@@ -346,6 +346,60 @@ func TestAccVPCNetworkInterface_attachment(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterface_enaSRDSpecification(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var conf types.NetworkInterface
+	resourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceConfig_enaSRDSpecification(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_ip_list_enabled", "ipv6_address_list_enabled"},
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_enaSRDSpecification(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_enaSRDSpecification(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInterface_ignoreExternalAttachment(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.NetworkInterface
@@ -984,6 +1038,34 @@ func regionalPrivateDNSSuffix(region string) string {
 	return fmt.Sprintf("%s.compute.internal", region)
 }
 
+func TestAccVPCNetworkInterface_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf types.NetworkInterface
+	resourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceConfig_skipDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+			{
+				// The retained network interface is deleted before the subnet is destroyed.
+				Config: testAccVPCNetworkInterfaceConfig_baseIPV4(rName),
+				Check:  testAccCheckENIRetained(ctx, &conf),
+			},
+		},
+	})
+}
+
 func testAccCheckENIExists(ctx context.Context, n string, v *types.NetworkInterface) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1035,6 +1117,25 @@ func testAccCheckENIDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckENIRetained checks that the EC2 Network Interface still exists after being removed from the configuration and then deletes it.
+func testAccCheckENIRetained(ctx context.Context, v *types.NetworkInterface) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		id := aws.ToString(v.NetworkInterfaceId)
+
+		if _, err := tfec2.FindNetworkInterfaceByID(ctx, conn, id); err != nil {
+			return fmt.Errorf("EC2 Network Interface %s not retained: %w", id, err)
+		}
+
+		_, err := conn.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: aws.String(id),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckENIMakeExternalAttachment(ctx context.Context, n string, networkInterface *types.NetworkInterface, attachmentId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1222,6 +1323,15 @@ resource "aws_network_interface" "test" {
 `)
 }
 
+func testAccVPCNetworkInterfaceConfig_skipDestroy(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV4(rName), `
+resource "aws_network_interface" "test" {
+  subnet_id    = aws_subnet.test.id
+  skip_destroy = true
+}
+`)
+}
+
 func testAccVPCNetworkInterfaceConfig_ipv6(rName string) string {
 	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV6(rName), fmt.Sprintf(`
 resource "aws_network_interface" "test" {
@@ -1341,6 +1451,46 @@ resource "aws_network_interface" "test" {
 `, rName))
 }
 
+func testAccVPCNetworkInterfaceConfig_enaSRDSpecification(rName string, enaSRDEnabled, enaSRDUDPEnabled bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "c6in.xlarge", "m6in.xlarge"),
+		testAccVPCNetworkInterfaceConfig_baseIPV4(rName),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id       = aws_subnet.test.id
+  security_groups = [aws_security_group.test.id]
+
+  attachment {
+    instance     = aws_instance.test.id
+    device_index = 1
+  }
+
+  ena_srd_specification {
+    ena_srd_enabled = %[2]t
+
+    ena_srd_udp_specification {
+      ena_srd_udp_enabled = %[3]t
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, enaSRDEnabled, enaSRDUDPEnabled))
+}
+
 func testAccVPCNetworkInterfaceConfig_externalAttachment(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -9,11 +9,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -42,6 +45,11 @@ func (d *securityGroupRulesDataSource) Schema(ctx context.Context, request datas
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"rules": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[securityGroupRulesDataSourceRuleModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[securityGroupRulesDataSourceRuleModel](ctx),
+			},
 			names.AttrTags: tftags.TagsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
@@ -58,6 +66,7 @@ func (d *securityGroupRulesDataSource) Read(ctx context.Context, request datasou
 	}
 
 	conn := d.Meta().EC2Client(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 
 	input := &ec2.DescribeSecurityGroupRulesInput{
 		Filters: append(newCustomFilterListFramework(ctx, data.Filters), newTagFilterList(Tags(tftags.New(ctx, data.Tags)))...),
@@ -80,13 +89,51 @@ func (d *securityGroupRulesDataSource) Read(ctx context.Context, request datasou
 	data.IDs = flex.FlattenFrameworkStringValueList(ctx, tfslices.ApplyToAll(output, func(v awstypes.SecurityGroupRule) string {
 		return aws.ToString(v.SecurityGroupRuleId)
 	}))
+	data.Rules = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfslices.ApplyToAll(output, func(v awstypes.SecurityGroupRule) securityGroupRulesDataSourceRuleModel {
+		tags := tfmaps.ApplyToAllValues(keyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(), func(v string) attr.Value {
+			return types.StringValue(v)
+		})
+
+		return securityGroupRulesDataSourceRuleModel{
+			CIDRIPv4:                  flex.StringToFramework(ctx, v.CidrIpv4),
+			CIDRIPv6:                  flex.StringToFramework(ctx, v.CidrIpv6),
+			Description:               flex.StringToFramework(ctx, v.Description),
+			FromPort:                  flex.Int32ToFramework(ctx, v.FromPort),
+			IPProtocol:                flex.StringToFramework(ctx, v.IpProtocol),
+			IsEgress:                  flex.BoolToFramework(ctx, v.IsEgress),
+			PrefixListID:              flex.StringToFramework(ctx, v.PrefixListId),
+			ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, v.ReferencedGroupInfo, d.Meta().AccountID),
+			SecurityGroupID:           flex.StringToFramework(ctx, v.GroupId),
+			SecurityGroupRuleID:       flex.StringToFramework(ctx, v.SecurityGroupRuleId),
+			Tags:                      fwtypes.NewMapValueOfMust[types.String](ctx, tags),
+			ToPort:                    flex.Int32ToFramework(ctx, v.ToPort),
+		}
+	}))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type securityGroupRulesDataSourceModel struct {
-	Filters types.Set    `tfsdk:"filter"`
-	ID      types.String `tfsdk:"id"`
-	IDs     types.List   `tfsdk:"ids"`
-	Tags    types.Map    `tfsdk:"tags"`
+	Filters types.Set                                                              `tfsdk:"filter"`
+	ID      types.String                                                           `tfsdk:"id"`
+	IDs     types.List                                                             `tfsdk:"ids"`
+	Rules   fwtypes.ListNestedObjectValueOf[securityGroupRulesDataSourceRuleModel] `tfsdk:"rules"`
+	Tags    types.Map                                                              `tfsdk:"tags"`
+}
+
+// securityGroupRulesDataSourceRuleModel mirrors the arguments of the aws_vpc_security_group_ingress_rule
+// and aws_vpc_security_group_egress_rule resources so that existing rules can be imported into them.
+type securityGroupRulesDataSourceRuleModel struct {
+	CIDRIPv4                  types.String                     `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String                     `tfsdk:"cidr_ipv6"`
+	Description               types.String                     `tfsdk:"description"`
+	FromPort                  types.Int64                      `tfsdk:"from_port"`
+	IPProtocol                types.String                     `tfsdk:"ip_protocol"`
+	IsEgress                  types.Bool                       `tfsdk:"is_egress"`
+	PrefixListID              types.String                     `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String                     `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String                     `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String                     `tfsdk:"security_group_rule_id"`
+	Tags                      fwtypes.MapValueOf[types.String] `tfsdk:"tags"`
+	ToPort                    types.Int64                      `tfsdk:"to_port"`
 }
@@ -26,6 +26,14 @@ func TestAccVPCSecurityGroupRulesDataSource_basic(t *testing.T) {
 				Config: testAccVPCSecurityGroupRulesDataSourceConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_vpc_security_group_rules.test", "rules.0.security_group_rule_id", "aws_vpc_security_group_ingress_rule.test", names.AttrID),
+					resource.TestCheckResourceAttrPair("data.aws_vpc_security_group_rules.test", "rules.0.security_group_id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.from_port", "80"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.is_egress", acctest.CtFalse),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.to_port", "8080"),
 				),
 			},
 		},
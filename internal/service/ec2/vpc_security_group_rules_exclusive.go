@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*securityGroupRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.SecurityGroupID.ValueString()
+	if err := syncSecurityGroupRules(ctx, conn, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Group Rules Exclusive (%s)", groupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.SecurityGroupID.ValueString()
+	_, err := findSecurityGroupByID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group Rules Exclusive (%s)", groupID), err.Error())
+
+		return
+	}
+
+	ingress, egress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group Rules Exclusive (%s)", groupID), err.Error())
+
+		return
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, egress)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, ingress)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.SecurityGroupID.ValueString()
+	if err := syncSecurityGroupRules(ctx, conn, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Security Group Rules Exclusive (%s)", groupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncSecurityGroupRules revokes any rules in the security group that are not in the desired sets.
+// Rules are created by aws_vpc_security_group_ingress_rule and aws_vpc_security_group_egress_rule, so desired rules missing from the group are left for those resources to create.
+func syncSecurityGroupRules(ctx context.Context, conn *ec2.Client, groupID string, wantIngress, wantEgress []string) error {
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return err
+	}
+
+	_, removeIngress, _ := flex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 })
+	_, removeEgress, _ := flex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(removeIngress) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeIngress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, input); err != nil {
+			return fmt.Errorf("revoking Security Group (%s) ingress rules: %w", groupID, err)
+		}
+	}
+
+	if len(removeEgress) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeEgress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, input); err != nil {
+			return fmt.Errorf("revoking Security Group (%s) egress rules: %w", groupID, err)
+		}
+	}
+
+	return nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
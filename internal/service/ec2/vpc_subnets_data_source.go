@@ -5,14 +5,17 @@ package ec2
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -40,26 +43,31 @@ func dataSourceSubnets() *schema.Resource {
 
 func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 
-	input := &ec2.DescribeSubnetsInput{}
+	var filters []awstypes.Filter
 
 	if tags, tagsOk := d.GetOk(names.AttrTags); tagsOk {
-		input.Filters = append(input.Filters, newTagFilterList(
+		filters = append(filters, newTagFilterList(
 			Tags(tftags.New(ctx, tags.(map[string]interface{}))),
 		)...)
 	}
 
-	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
-		input.Filters = append(input.Filters,
-			newCustomFilterList(filters.(*schema.Set))...)
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		filters = append(filters,
+			newCustomFilterList(v.(*schema.Set))...)
 	}
 
-	if len(input.Filters) == 0 {
-		input.Filters = nil
-	}
+	// The subnets in each requested VPC are listed concurrently.
+	outputs, err := tfslices.ApplyToAllConcurrentlyWithError(splitFilterList(filters, "vpc-id"), c.ListConcurrency(ctx), func(filters []awstypes.Filter) ([]awstypes.Subnet, error) {
+		input := &ec2.DescribeSubnetsInput{
+			Filters:    filters,
+			MaxResults: aws.Int32(1000),
+		}
 
-	output, err := findSubnets(ctx, conn, input)
+		return findSubnets(ctx, conn, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
@@ -67,11 +75,11 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	var subnetIDs []string
 
-	for _, v := range output {
+	for _, v := range slices.Concat(outputs...) {
 		subnetIDs = append(subnetIDs, aws.ToString(v.SubnetId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(c.Region)
 	d.Set(names.AttrIDs, subnetIDs)
 
 	return diags
@@ -705,6 +705,25 @@ func waitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.Client
 	return nil, err
 }
 
+func waitInstanceStatusChecksOK(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SummaryStatusInitializing, awstypes.SummaryStatusInsufficientData),
+		Target:     enum.Slice(awstypes.SummaryStatusOk),
+		Refresh:    statusInstanceStatusChecks(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn *ec2.Client, id, expectedValue string, timeout time.Duration) (*awstypes.InstanceMaintenanceOptions, error) {
 	stateConf := &retry.StateChangeConf{
 		Target:     enum.Slice(expectedValue),
@@ -831,6 +850,47 @@ func waitIPAMDeleted(ctx context.Context, conn *ec2.Client, id string, timeout t
 	return nil, err
 }
 
+func waitIPAMBYOASNAssociated(ctx context.Context, conn *ec2.Client, asn, cidrBlock string, timeout time.Duration) (*awstypes.AsnAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.AsnAssociationStatePendingAssociation),
+		Target:         enum.Slice(awstypes.AsnAssociationStateAssociated),
+		Refresh:        statusIPAMBYOASNAssociation(ctx, conn, asn, cidrBlock),
+		Timeout:        timeout,
+		Delay:          5 * time.Second,
+		NotFoundChecks: 1000, // Should exceed any reasonable custom timeout value.
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AsnAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIPAMBYOASNDisassociated(ctx context.Context, conn *ec2.Client, asn, cidrBlock string, timeout time.Duration) (*awstypes.AsnAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AsnAssociationStatePendingDisassociation, awstypes.AsnAssociationStateAssociated),
+		Target:  []string{},
+		Refresh: statusIPAMBYOASNAssociation(ctx, conn, asn, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AsnAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIPAMPoolCIDRCreated(ctx context.Context, conn *ec2.Client, poolCIDRID, poolID, cidrBlock string, timeout time.Duration) (*awstypes.IpamPoolCidr, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(awstypes.IpamPoolCidrStatePendingProvision),
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -296,6 +297,33 @@ func TestAccECRRepository_Encryption_aes256(t *testing.T) {
 	})
 }
 
+func TestAccECRRepository_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Repository
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfig_skipDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+			{
+				Config: "# Empty config",
+				Check:  testAccCheckRepositoryRetained(ctx, &v),
+			},
+		},
+	})
+}
+
 func testAccCheckRepositoryDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
@@ -346,6 +374,26 @@ func testAccCheckRepositoryExists(ctx context.Context, n string, v *types.Reposi
 	}
 }
 
+// testAccCheckRepositoryRetained checks that the ECR Repository still exists after being removed from the configuration and then deletes it.
+func testAccCheckRepositoryRetained(ctx context.Context, v *types.Repository) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
+
+		name := aws.ToString(v.RepositoryName)
+
+		if _, err := tfecr.FindRepositoryByName(ctx, conn, name); err != nil {
+			return fmt.Errorf("ECR Repository %s not retained: %w", name, err)
+		}
+
+		_, err := conn.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+			Force:          true,
+			RepositoryName: aws.String(name),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckRepositoryRegistryID(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		attributeValue := acctest.AccountID()
@@ -388,6 +436,15 @@ resource "aws_ecr_repository" "test" {
 `, rName)
 }
 
+func testAccRepositoryConfig_skipDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name         = %[1]q
+  skip_destroy = true
+}
+`, rName)
+}
+
 func testAccRepositoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ecr_repository" "test" {
@@ -529,10 +529,9 @@ func resourceService() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"alarms": {
-				Type:             schema.TypeList,
-				Optional:         true,
-				MaxItems:         1,
-				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"alarm_names": {
@@ -1089,6 +1088,7 @@ func resourceService() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
 			capacityProviderStrategyCustomizeDiff,
+			deploymentConfigurationCustomizeDiff,
 			triggersCustomizeDiff,
 		),
 	}
@@ -1272,7 +1272,7 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
 		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
 
-		if service.DeploymentConfiguration.Alarms != nil {
+		if service.DeploymentConfiguration.Alarms != nil && !deploymentAlarmsIsRemoved(service.DeploymentConfiguration.Alarms) {
 			if err := d.Set("alarms", []interface{}{flattenAlarms(service.DeploymentConfiguration.Alarms)}); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting alarms: %s", err)
 			}
@@ -1363,6 +1363,11 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				input.DeploymentConfiguration = &awstypes.DeploymentConfiguration{}
 			}
 
+			// To remove existing deployment alarms, disable them and specify an empty list of alarm names.
+			input.DeploymentConfiguration.Alarms = &awstypes.DeploymentAlarms{
+				AlarmNames: []string{},
+			}
+
 			if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.DeploymentConfiguration.Alarms = expandAlarms(v.([]interface{})[0].(map[string]interface{}))
 			}
@@ -1882,6 +1887,34 @@ func waitServiceInactive(ctx context.Context, conn *ecs.Client, serviceName, clu
 	return nil, err
 }
 
+func deploymentConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("deployment_controller") {
+		return nil
+	}
+
+	deploymentControllerType := awstypes.DeploymentControllerTypeEcs
+	if v, ok := d.Get("deployment_controller").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})[names.AttrType].(string); ok && v != "" {
+			deploymentControllerType = awstypes.DeploymentControllerType(v)
+		}
+	}
+
+	if deploymentControllerType == awstypes.DeploymentControllerTypeEcs {
+		return nil
+	}
+
+	// Deployment alarms and the deployment circuit breaker are only supported by the rolling update (ECS) deployment controller.
+	for _, key := range []string{"alarms", "deployment_circuit_breaker"} {
+		if v, ok := d.Get(key).([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["enable"].(bool); ok && v {
+				return fmt.Errorf("%s can only be enabled when deployment_controller type is %q, got %q", key, awstypes.DeploymentControllerTypeEcs, deploymentControllerType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// clears diff to avoid extraneous diffs but lets it pass for triggering update
 	fnd := false
@@ -1958,6 +1991,11 @@ func expandAlarms(tfMap map[string]interface{}) *awstypes.DeploymentAlarms {
 	return apiObject
 }
 
+// deploymentAlarmsIsRemoved returns whether the deployment alarms have been removed, i.e. are disabled with no alarm names.
+func deploymentAlarmsIsRemoved(apiObject *awstypes.DeploymentAlarms) bool {
+	return !apiObject.Enable && !apiObject.Rollback && len(apiObject.AlarmNames) == 0
+}
+
 func flattenAlarms(apiObject *awstypes.DeploymentAlarms) map[string]interface{} {
 	if apiObject == nil {
 		return nil
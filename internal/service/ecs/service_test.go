@@ -680,6 +680,61 @@ func TestAccECSService_alarmsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccECSService_alarmsRemove(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_alarms(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccServiceConfig_noAlarms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSService_alarmsDeploymentController(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig_alarmsDeploymentController(rName, "CODE_DEPLOY"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`alarms can only be enabled when deployment_controller type is "ECS"`),
+			},
+			{
+				Config:      testAccServiceConfig_alarmsDeploymentController(rName, "EXTERNAL"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`alarms can only be enabled when deployment_controller type is "ECS"`),
+			},
+		},
+	})
+}
+
 func TestAccECSService_DeploymentValues_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
@@ -2905,6 +2960,61 @@ resource "aws_cloudwatch_metric_alarm" "test" {
 `, rName, enable)
 }
 
+func testAccServiceConfig_alarmsDeploymentController(rName, deploymentControllerType string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  deployment_controller {
+    type = %[2]q
+  }
+
+  alarms {
+    enable   = true
+    rollback = true
+    alarm_names = [
+      aws_cloudwatch_metric_alarm.test.alarm_name
+    ]
+  }
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name                = %[1]q
+  comparison_operator       = "GreaterThanOrEqualToThreshold"
+  evaluation_periods        = "2"
+  metric_name               = "CPUReservation"
+  namespace                 = "AWS/ECS"
+  period                    = "120"
+  statistic                 = "Average"
+  threshold                 = "80"
+  insufficient_data_actions = []
+}
+`, rName, deploymentControllerType)
+}
+
 func testAccServiceConfig_noAlarms(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
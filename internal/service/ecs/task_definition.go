@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -42,7 +43,7 @@ func resourceTaskDefinition() *schema.Resource {
 		CreateWithoutTimeout: resourceTaskDefinitionCreate,
 		ReadWithoutTimeout:   resourceTaskDefinitionRead,
 		UpdateWithoutTimeout: resourceTaskDefinitionUpdate,
-		DeleteWithoutTimeout: resourceTaskDefinitionDelete,
+		DeleteWithoutTimeout: sdkv2.SkipDestroyDeleteFunc("ECS Task Definition Revision", resourceTaskDefinitionDelete),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -274,13 +275,9 @@ func resourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
-			names.AttrSkipDestroy: {
-				Type:     schema.TypeBool,
-				Default:  false,
-				Optional: true,
-			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrSkipDestroy: sdkv2.SkipDestroySchema(),
+			names.AttrTags:        tftags.TagsSchema(),
+			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 			"task_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -653,11 +650,6 @@ func resourceTaskDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
-		log.Printf("[DEBUG] Retaining ECS Task Definition Revision: %s", d.Id())
-		return diags
-	}
-
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
 	_, err := conn.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
@@ -6,14 +6,17 @@ package ecs_test
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1192,6 +1195,7 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 	ctx := acctest.Context(t)
 	var def awstypes.TaskDefinition
+	var revision string
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_definition.test"
 
@@ -1217,6 +1221,20 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy, "track_latest"},
 			},
+			{
+				// Simulate a revision registered outside of Terraform, e.g. by a CI pipeline.
+				PreConfig: func() { testAccRegisterTaskDefinitionRevision(ctx, t, &def, &revision) },
+				Config:    testAccTaskDefinitionConfig_trackLatest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttrPtr(resourceName, "revision", &revision),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
 		},
 	})
 }
@@ -1364,6 +1382,35 @@ func testAccCheckTaskDefinitionExists(ctx context.Context, n string, v *awstypes
 	}
 }
 
+// testAccRegisterTaskDefinitionRevision registers a new revision of the specified task definition
+// and records its revision number.
+func testAccRegisterTaskDefinitionRevision(ctx context.Context, t *testing.T, v *awstypes.TaskDefinition, revision *string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+	input := &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions:    v.ContainerDefinitions,
+		Cpu:                     v.Cpu,
+		ExecutionRoleArn:        v.ExecutionRoleArn,
+		Family:                  v.Family,
+		Memory:                  v.Memory,
+		NetworkMode:             v.NetworkMode,
+		PlacementConstraints:    v.PlacementConstraints,
+		RequiresCompatibilities: v.RequiresCompatibilities,
+		TaskRoleArn:             v.TaskRoleArn,
+		Volumes:                 v.Volumes,
+	}
+
+	output, err := conn.RegisterTaskDefinition(ctx, input)
+
+	if err != nil {
+		t.Fatalf("registering ECS Task Definition (%s) revision: %s", aws.ToString(v.Family), err)
+	}
+
+	*revision = strconv.Itoa(int(output.TaskDefinition.Revision))
+}
+
 func testAccCheckTaskDefinitionDockerVolumeConfigurationAutoprovisionNil(def *awstypes.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(def.Volumes) != 1 {
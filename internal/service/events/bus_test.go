@@ -109,6 +109,53 @@ func TestAccEventsBus_kmsKeyIdentifier(t *testing.T) {
 	})
 }
 
+func TestAccEventsBus_deadLetterConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 eventbridge.DescribeEventBusOutput
+	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusConfig_deadLetterConfig(busName, 0, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", "aws_sqs_queue.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBusConfig_deadLetterConfig(busName, 1, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v2),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", "aws_sqs_queue.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+			{
+				Config: testAccBusConfig_basic(busName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v3),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEventsBus_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 eventbridge.DescribeEventBusOutput
@@ -302,6 +349,25 @@ resource "aws_cloudwatch_event_bus" "test" {
 `, name)
 }
 
+func testAccBusConfig_deadLetterConfig(name string, queueIndex int, description string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  name        = %[1]q
+  description = %[3]q
+
+  dead_letter_config {
+    arn = aws_sqs_queue.test[%[2]d].arn
+  }
+}
+`, name, queueIndex, description)
+}
+
 func testAccBusConfig_tags1(name, key, value string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Route 53 considers an endpoint healthy when more than 18% of its health checkers report it healthy.
+const healthCheckHealthyThreshold = 0.18
+
+// @SDKDataSource("aws_cloudwatch_event_endpoint", name="Global Endpoint")
+func dataSourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"active_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_bus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_bus_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrHealthCheck: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"primary_healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"primary_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secondary_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := findEndpointByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Global Endpoint (%s): %s", name, err)
+	}
+
+	// Global endpoints are created in their primary Region.
+	endpointARN, err := arn.Parse(aws.ToString(output.Arn))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	primaryRegion := endpointARN.Region
+
+	var healthCheck, secondaryRegion string
+	if v := output.RoutingConfig; v != nil && v.FailoverConfig != nil {
+		if v := v.FailoverConfig.Primary; v != nil {
+			healthCheck = aws.ToString(v.HealthCheck)
+		}
+		if v := v.FailoverConfig.Secondary; v != nil {
+			secondaryRegion = aws.ToString(v.Route)
+		}
+	}
+
+	primaryHealthy := true
+	if healthCheck != "" {
+		primaryHealthy, err = findHealthCheckHealthy(ctx, meta.(*conns.AWSClient).Route53Client(ctx), healthCheck)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EventBridge Global Endpoint (%s) health check (%s) status: %s", name, healthCheck, err)
+		}
+	}
+
+	activeRegion := primaryRegion
+	if !primaryHealthy && secondaryRegion != "" {
+		activeRegion = secondaryRegion
+	}
+
+	d.SetId(name)
+	d.Set("active_region", activeRegion)
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("endpoint_url", output.EndpointUrl)
+	if err := d.Set("event_bus", flattenEndpointEventBuses(output.EventBuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting event_bus: %s", err)
+	}
+	d.Set(names.AttrHealthCheck, healthCheck)
+	d.Set(names.AttrName, output.Name)
+	d.Set("primary_healthy", primaryHealthy)
+	d.Set("primary_region", primaryRegion)
+	if v := output.ReplicationConfig; v != nil {
+		d.Set("replication_state", v.State)
+	} else {
+		d.Set("replication_state", nil)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("secondary_region", secondaryRegion)
+	d.Set(names.AttrState, output.State)
+	d.Set("state_reason", output.StateReason)
+
+	return diags
+}
+
+// findHealthCheckHealthy returns whether the Route 53 health check with the specified ARN currently reports healthy.
+func findHealthCheckHealthy(ctx context.Context, conn *route53.Client, healthCheckARN string) (bool, error) {
+	parsedARN, err := arn.Parse(healthCheckARN)
+	if err != nil {
+		return false, err
+	}
+
+	input := &route53.GetHealthCheckStatusInput{
+		HealthCheckId: aws.String(strings.TrimPrefix(parsedARN.Resource, "healthcheck/")),
+	}
+
+	output, err := conn.GetHealthCheckStatus(ctx, input)
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(output.HealthCheckObservations) == 0 {
+		return false, nil
+	}
+
+	var healthy int
+	for _, v := range output.HealthCheckObservations {
+		if v.StatusReport != nil && strings.HasPrefix(aws.ToString(v.StatusReport.Status), "Success") {
+			healthy++
+		}
+	}
+
+	return float64(healthy)/float64(len(output.HealthCheckObservations)) > healthCheckHealthyThreshold, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_endpoint.test"
+	resourceName := "aws_cloudwatch_event_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "active_region"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_url", resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_bus.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrHealthCheck, "aws_route53_health_check.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_healthy"),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "replication_state", "DISABLED"),
+					resource.TestCheckResourceAttr(dataSourceName, "secondary_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrState, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEndpointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEndpointConfig_basic(rName), `
+data "aws_cloudwatch_event_endpoint" "test" {
+  name = aws_cloudwatch_event_endpoint.test.name
+}
+`)
+}
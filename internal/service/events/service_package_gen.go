@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_event_connection",
 			Name:     "Connection",
 		},
+		{
+			Factory:  dataSourceEndpoint,
+			TypeName: "aws_cloudwatch_event_endpoint",
+			Name:     "Global Endpoint",
+		},
 		{
 			Factory:  dataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
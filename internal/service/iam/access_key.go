@@ -294,6 +294,31 @@ func findAccessKeysByUser(ctx context.Context, conn *iam.Client, username string
 	return findAccessKeys(ctx, conn, input, tfslices.PredicateTrue[awstypes.AccessKeyMetadata]())
 }
 
+func findAccessKeyLastUsedByID(ctx context.Context, conn *iam.Client, id string) (*awstypes.AccessKeyLastUsed, error) {
+	input := &iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(id),
+	}
+
+	output, err := conn.GetAccessKeyLastUsed(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessKeyLastUsed == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessKeyLastUsed, nil
+}
+
 func findAccessKey(ctx context.Context, conn *iam.Client, input *iam.ListAccessKeysInput, filter tfslices.Predicate[awstypes.AccessKeyMetadata]) (*awstypes.AccessKeyMetadata, error) {
 	output, err := findAccessKeys(ctx, conn, input, filter)
 
@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -34,6 +36,18 @@ func dataSourceAccessKeys() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"last_used_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						names.AttrStatus: {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -61,29 +75,39 @@ func dataSourceAccessKeysRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading IAM Access Keys (%s): %s", username, err)
 	}
 
-	d.SetId(username)
-	if err := d.Set("access_keys", flattenAccessKeys(output)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting access_keys: %s", err)
-	}
-
-	return diags
-}
-
-func flattenAccessKeys(apiObjects []awstypes.AccessKeyMetadata) []interface{} {
-	if len(apiObjects) == 0 {
-		return nil
-	}
-
 	var tfList []interface{}
 
-	for _, apiObject := range apiObjects {
+	for _, apiObject := range output {
 		if apiObject == (awstypes.AccessKeyMetadata{}) {
 			continue
 		}
-		tfList = append(tfList, flattenAccessKey(apiObject))
+
+		tfMap := flattenAccessKey(apiObject)
+
+		accessKeyID := aws.ToString(apiObject.AccessKeyId)
+		lastUsed, err := findAccessKeyLastUsedByID(ctx, conn, accessKeyID)
+
+		switch {
+		case tfresource.NotFound(err):
+			// The access key was deleted after it was listed. Leave its last used attributes unset.
+			log.Printf("[WARN] IAM Access Key (%s) last used not found", accessKeyID)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading IAM Access Key (%s) last used: %s", accessKeyID, err)
+		default:
+			for k, v := range flattenAccessKeyLastUsed(lastUsed) {
+				tfMap[k] = v
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(username)
+	if err := d.Set("access_keys", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_keys: %s", err)
 	}
 
-	return tfList
+	return diags
 }
 
 func flattenAccessKey(apiObject awstypes.AccessKeyMetadata) map[string]interface{} {
@@ -105,3 +129,23 @@ func flattenAccessKey(apiObject awstypes.AccessKeyMetadata) map[string]interface
 
 	return m
 }
+
+func flattenAccessKeyLastUsed(apiObject *awstypes.AccessKeyLastUsed) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.LastUsedDate; v != nil {
+		m["last_used_date"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+	if v := apiObject.Region; v != nil {
+		m["last_used_region"] = aws.ToString(v)
+	}
+	if v := apiObject.ServiceName; v != nil {
+		m["last_used_service_name"] = aws.ToString(v)
+	}
+
+	return m
+}
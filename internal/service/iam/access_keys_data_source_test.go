@@ -35,6 +35,8 @@ func TestAccIAMAccessKeysDataSource_basic(t *testing.T) {
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "access_keys.0.create_date", resourceName, "create_date"),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "access_keys.0.access_key_id", resourceName, names.AttrID),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "access_keys.0.status", resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(dataSourceName, "access_keys.0.last_used_region", "N/A"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_keys.0.last_used_service_name", "N/A"),
 				),
 			},
 		},
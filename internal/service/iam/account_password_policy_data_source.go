@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_iam_account_password_policy", name="Account Password Policy")
+func dataSourceAccountPasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountPasswordPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"allow_users_to_change_password": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expire_passwords": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hard_expiry": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_password_age": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minimum_password_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"password_reuse_prevention": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"require_lowercase_characters": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"require_numbers": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"require_symbols": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"require_uppercase_characters": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccountPasswordPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	policy, err := findAccountPasswordPolicy(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("IAM Account Password Policy", err))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("allow_users_to_change_password", policy.AllowUsersToChangePassword)
+	d.Set("expire_passwords", policy.ExpirePasswords)
+	d.Set("hard_expiry", policy.HardExpiry)
+	d.Set("max_password_age", policy.MaxPasswordAge)
+	d.Set("minimum_password_length", policy.MinimumPasswordLength)
+	d.Set("password_reuse_prevention", policy.PasswordReusePrevention)
+	d.Set("require_lowercase_characters", policy.RequireLowercaseCharacters)
+	d.Set("require_numbers", policy.RequireNumbers)
+	d.Set("require_symbols", policy.RequireSymbols)
+	d.Set("require_uppercase_characters", policy.RequireUppercaseCharacters)
+
+	return diags
+}
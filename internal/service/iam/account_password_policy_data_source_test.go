@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccountPasswordPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_account_password_policy.test"
+	resourceName := "aws_iam_account_password_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPasswordPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPasswordPolicyDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "allow_users_to_change_password", resourceName, "allow_users_to_change_password"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "expire_passwords", resourceName, "expire_passwords"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hard_expiry", resourceName, "hard_expiry"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_password_age", resourceName, "max_password_age"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "minimum_password_length", resourceName, "minimum_password_length"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "password_reuse_prevention", resourceName, "password_reuse_prevention"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "require_lowercase_characters", resourceName, "require_lowercase_characters"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "require_numbers", resourceName, "require_numbers"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "require_symbols", resourceName, "require_symbols"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "require_uppercase_characters", resourceName, "require_uppercase_characters"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountPasswordPolicyDataSourceConfig_basic = `
+resource "aws_iam_account_password_policy" "test" {
+  allow_users_to_change_password = true
+  minimum_password_length        = 12
+  password_reuse_prevention      = 24
+  require_numbers                = true
+}
+
+data "aws_iam_account_password_policy" "test" {
+  depends_on = [aws_iam_account_password_policy.test]
+}
+`
@@ -24,6 +24,7 @@ func TestAccIAMAccountPasswordPolicy_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic:      testAccAccountPasswordPolicy_basic,
 		acctest.CtDisappears: testAccAccountPasswordPolicy_disappears,
+		"dataSource":         testAccAccountPasswordPolicyDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Credential report columns that hold "true" or "false".
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/id_credentials_getting-report.html#id_credentials_understanding_the_report_format
+var credentialReportBoolColumns = []string{
+	"access_key_1_active",
+	"access_key_2_active",
+	"cert_1_active",
+	"cert_2_active",
+	"mfa_active",
+}
+
+// Credential report columns that hold free-form values, e.g. timestamps, "N/A" or "no_information".
+var credentialReportStringColumns = []string{
+	"access_key_1_last_rotated",
+	"access_key_1_last_used_date",
+	"access_key_1_last_used_region",
+	"access_key_1_last_used_service",
+	"access_key_2_last_rotated",
+	"access_key_2_last_used_date",
+	"access_key_2_last_used_region",
+	"access_key_2_last_used_service",
+	names.AttrARN,
+	"cert_1_last_rotated",
+	"cert_2_last_rotated",
+	"password_enabled",
+	"password_last_changed",
+	"password_last_used",
+	"password_next_rotation",
+	"user",
+	"user_creation_time",
+}
+
+// @SDKDataSource("aws_iam_credential_report", name="Credential Report")
+func dataSourceCredentialReport() *schema.Resource {
+	userSchema := map[string]*schema.Schema{}
+
+	for _, v := range credentialReportBoolColumns {
+		userSchema[v] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+	for _, v := range credentialReportStringColumns {
+		userSchema[v] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCredentialReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"generated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: userSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	if err := generateCredentialReport(ctx, conn, d.Timeout(schema.TimeoutRead)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM Credential Report: %s", err)
+	}
+
+	output, err := conn.GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Credential Report: %s", err)
+	}
+
+	users, err := parseCredentialReport(output.Content)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing IAM Credential Report: %s", err)
+	}
+
+	generatedTime := aws.ToTime(output.GeneratedTime).Format(time.RFC3339)
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set(names.AttrContent, string(output.Content))
+	d.Set("generated_time", generatedTime)
+	if err := d.Set("users", users); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting users: %s", err)
+	}
+
+	return diags
+}
+
+// generateCredentialReport starts generation of a credential report, if necessary,
+// and waits for the report to be available.
+// A report newer than four hours is reused by IAM rather than regenerated.
+func generateCredentialReport(ctx context.Context, conn *iam.Client, timeout time.Duration) error {
+	input := &iam.GenerateCredentialReportInput{}
+
+	_, err := tfresource.RetryUntilEqual(ctx, timeout, awstypes.ReportStateTypeComplete, func() (awstypes.ReportStateType, error) {
+		output, err := conn.GenerateCredentialReport(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		return output.State, nil
+	})
+
+	return err
+}
+
+// parseCredentialReport parses the CSV credential report into a list of per-user attribute maps.
+func parseCredentialReport(content []byte) ([]interface{}, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	tfList := make([]interface{}, 0, len(records)-1)
+
+	for _, record := range records[1:] {
+		tfMap := map[string]interface{}{}
+
+		// Columns not known to the schema are ignored.
+		for i, column := range header {
+			if i >= len(record) {
+				break
+			}
+
+			switch v := record[i]; {
+			case slices.Contains(credentialReportBoolColumns, column):
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf("column %s: %w", column, err)
+				}
+				tfMap[column] = b
+			case slices.Contains(credentialReportStringColumns, column):
+				tfMap[column] = v
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseCredentialReport(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`user,arn,user_creation_time,password_enabled,mfa_active,access_key_1_active,access_key_1_last_rotated,future_column
+<root_account>,arn:aws:iam::123456789012:root,2020-01-01T00:00:00+00:00,not_supported,true,false,N/A,x
+tf-acc-test,arn:aws:iam::123456789012:user/tf-acc-test,2021-02-03T04:05:06+00:00,false,false,true,2021-02-03T04:05:07+00:00,y
+`) //lintignore:AWSAT005
+
+	got, err := tfiam.ParseCredentialReport(content)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d users, want 2", len(got))
+	}
+
+	user := got[1].(map[string]interface{})
+
+	if got, want := user["user"], "tf-acc-test"; got != want {
+		t.Errorf("user = %v, want %v", got, want)
+	}
+	if got, want := user["access_key_1_active"], true; got != want {
+		t.Errorf("access_key_1_active = %v, want %v", got, want)
+	}
+	if got, want := user["access_key_1_last_rotated"], "2021-02-03T04:05:07+00:00"; got != want {
+		t.Errorf("access_key_1_last_rotated = %v, want %v", got, want)
+	}
+	if _, ok := user["future_column"]; ok {
+		t.Errorf("unexpected future_column")
+	}
+
+	if _, err := tfiam.ParseCredentialReport([]byte("user,mfa_active\nx,maybe\n")); err == nil {
+		t.Errorf("expected error for invalid boolean column")
+	}
+}
+
+func TestAccIAMCredentialReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_credential_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialReportDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrContent),
+					resource.TestCheckResourceAttrSet(dataSourceName, "generated_time"),
+					resource.TestMatchResourceAttr(dataSourceName, "users.#", regexache.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.user", "<root_account>"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCredentialReportDataSourceConfig_basic = `
+data "aws_iam_credential_report" "test" {}
+`
@@ -31,6 +31,7 @@ var (
 	ResourceUserSSHKey                = resourceUserSSHKey
 	ResourceVirtualMFADevice          = resourceVirtualMFADevice
 
+	FetchSAMLMetadataDocument           = fetchSAMLMetadataDocument
 	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
 	FindAccountPasswordPolicy           = findAccountPasswordPolicy
 	FindAttachedGroupPolicies           = findAttachedGroupPolicies
@@ -41,13 +42,21 @@ var (
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
 	FindGroupByName                     = findGroupByName
+	FindGroupPolicyNames                = findGroupPolicyNames
 	FindInstanceProfileByName           = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
+	FindOrganizationsFeatures           = findOrganizationsFeatures
 	FindPolicyByARN                     = findPolicyByARN
+	FindRolePolicyNames                 = findRolePolicyNames
 	FindSAMLProviderByARN               = findSAMLProviderByARN
 	FindServerCertificateByName         = findServerCertificateByName
 	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
 	FindUserByName                      = findUserByName
+	FindUserPolicyNames                 = findUserPolicyNames
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
+	FlattenPolicyStatements             = flattenPolicyStatements
+	OpenIDConnectProviderThumbprint     = openIDConnectProviderThumbprint
+	ParseCredentialReport               = parseCredentialReport
+	PolicyDocumentStatements            = policyDocumentStatements
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 )
@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -92,6 +93,55 @@ func TestAccIAMGroupMembership_paginatedUserList(t *testing.T) {
 	})
 }
 
+func TestAccIAMGroupMembership_removesUnmanagedUsers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group iam.GetGroupOutput
+
+	rString := sdkacctest.RandString(8)
+	groupName := fmt.Sprintf("tf-acc-group-gm-unmanaged-%s", rString)
+	userName := fmt.Sprintf("tf-acc-user-gm-unmanaged-%s", rString)
+	userName2 := fmt.Sprintf("tf-acc-user-gm-unmanaged-two-%s", rString)
+	membershipName := fmt.Sprintf("tf-acc-membership-gm-unmanaged-%s", rString)
+	resourceName := "aws_iam_group_membership.team"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_unmanagedUser(groupName, userName, userName2, membershipName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipExists(ctx, resourceName, &group),
+					testAccCheckGroupMembershipAddUser(ctx, groupName, userName2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipConfig_unmanagedUser(groupName, userName, userName2, membershipName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipExists(ctx, resourceName, &group),
+					testAccCheckGroupMembershipAttributes(&group, groupName, []string{userName}),
+					resource.TestCheckResourceAttr(resourceName, "users.#", acctest.Ct1),
+					func(s *terraform.State) error {
+						if got, want := len(group.Users), 1; got != want {
+							return fmt.Errorf("IAM Group (%s) has %d users, want %d", groupName, got, want)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGroupMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -172,6 +222,20 @@ func testAccCheckGroupMembershipAttributes(group *iam.GetGroupOutput, groupName
 	}
 }
 
+// testAccCheckGroupMembershipAddUser adds a user to the group outside of Terraform.
+func testAccCheckGroupMembershipAddUser(ctx context.Context, groupName, userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := conn.AddUserToGroup(ctx, &iam.AddUserToGroupInput{
+			GroupName: aws.String(groupName),
+			UserName:  aws.String(userName),
+		})
+
+		return err
+	}
+}
+
 func testAccGroupMembershipConfig_member(groupName, userName, membershipName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_group" "group" {
@@ -365,3 +429,26 @@ resource "aws_iam_user" "user" {
 }
 `, groupName, membershipName, userNamePrefix)
 }
+
+func testAccGroupMembershipConfig_unmanagedUser(groupName, userName, userName2, membershipName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "group" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "user" {
+  name = %[2]q
+}
+
+resource "aws_iam_user" "user2" {
+  name          = %[3]q
+  force_destroy = true
+}
+
+resource "aws_iam_group_membership" "team" {
+  name  = %[4]q
+  users = [aws_iam_user.user.name]
+  group = aws_iam_group.group.name
+}
+`, groupName, userName, userName2, membershipName)
+}
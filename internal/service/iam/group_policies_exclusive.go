@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
+func newGroupPoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPoliciesExclusiveResource{}, nil
+}
+
+type groupPoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*groupPoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policies_exclusive"
+}
+
+func (r *groupPoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrGroupName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *groupPoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := syncGroupPolicies(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	output, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := syncGroupPolicies(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrGroupName), request, response)
+}
+
+// syncGroupPolicies deletes any inline policies attached to the group that are not in the desired set.
+// Inline policies are created by aws_iam_group_policy, so desired policies missing from the group are left for that resource to create.
+func syncGroupPolicies(ctx context.Context, conn *iam.Client, groupName string, want []string) error {
+	have, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return err
+	}
+
+	_, remove, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, name := range remove {
+		input := &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(name),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.DeleteGroupPolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Group (%s) Policy (%s): %w", groupName, name, err)
+		}
+	}
+
+	return nil
+}
+
+func findGroupPolicyNames(ctx context.Context, conn *iam.Client, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	pages := iam.NewListGroupPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != "" {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type groupPoliciesExclusiveResourceModel struct {
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	GroupName   types.String `tfsdk:"group_name"`
+}
@@ -37,6 +37,10 @@ func resourceGroupPolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group": {
 				Type:     schema.TypeString,
@@ -69,6 +73,8 @@ func resourceGroupPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customizeDiffPolicySize("group inline", policySizeQuotaGroupInline),
 	}
 }
 
@@ -98,7 +104,7 @@ func resourceGroupPolicyPut(ctx context.Context, d *schema.ResourceData, meta in
 	if d.IsNewResource() {
 		d.SetId(fmt.Sprintf("%s:%s", groupName, policyName))
 
-		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		_, err := tfresource.RetryWhenNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 			return FindGroupPolicyByTwoPartKey(ctx, conn, groupName, policyName)
 		})
 
@@ -9,6 +9,7 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -36,6 +37,10 @@ func resourceGroupPolicyAttachment() *schema.Resource {
 			StateContext: resourceGroupPolicyAttachmentImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group": {
 				Type:     schema.TypeString,
@@ -59,7 +64,7 @@ func resourceGroupPolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 	group := d.Get("group").(string)
 	policyARN := d.Get("policy_arn").(string)
 
-	if err := attachPolicyToGroup(ctx, conn, group, policyARN); err != nil {
+	if err := attachPolicyToGroup(ctx, conn, group, policyARN, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -78,7 +83,7 @@ func resourceGroupPolicyAttachmentRead(ctx context.Context, d *schema.ResourceDa
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", group, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findAttachedGroupPolicyByTwoPartKey(ctx, conn, group, policyARN)
 	}, d.IsNewResource())
 
@@ -99,7 +104,7 @@ func resourceGroupPolicyAttachmentDelete(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if err := detachPolicyFromGroup(ctx, conn, d.Get("group").(string), d.Get("policy_arn").(string)); err != nil {
+	if err := detachPolicyFromGroup(ctx, conn, d.Get("group").(string), d.Get("policy_arn").(string), providerPropagationTimeout(ctx, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -122,9 +127,9 @@ func resourceGroupPolicyAttachmentImport(ctx context.Context, d *schema.Resource
 	return []*schema.ResourceData{d}, nil
 }
 
-func attachPolicyToGroup(ctx context.Context, conn *iam.Client, group, policyARN string) error {
+func attachPolicyToGroup(ctx context.Context, conn *iam.Client, group, policyARN string, timeout time.Duration) error {
 	var errConcurrentModificationException *awstypes.ConcurrentModificationException
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.AttachGroupPolicy(ctx, &iam.AttachGroupPolicyInput{
 			GroupName: aws.String(group),
 			PolicyArn: aws.String(policyARN),
@@ -138,9 +143,9 @@ func attachPolicyToGroup(ctx context.Context, conn *iam.Client, group, policyARN
 	return nil
 }
 
-func detachPolicyFromGroup(ctx context.Context, conn *iam.Client, group, policyARN string) error {
+func detachPolicyFromGroup(ctx context.Context, conn *iam.Client, group, policyARN string, timeout time.Duration) error {
 	var errConcurrentModificationException *awstypes.ConcurrentModificationException
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DetachGroupPolicy(ctx, &iam.DetachGroupPolicyInput{
 			GroupName: aws.String(group),
 			PolicyArn: aws.String(policyARN),
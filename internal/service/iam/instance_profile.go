@@ -45,6 +45,10 @@ func resourceInstanceProfile() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -119,7 +123,7 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.ToString(output.InstanceProfile.InstanceProfileName))
 
-	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err = tfresource.RetryWhenNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findInstanceProfileByName(ctx, conn, d.Id())
 	})
 
@@ -128,7 +132,7 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk(names.AttrRole); ok {
-		err := instanceProfileAddRole(ctx, conn, d.Id(), v.(string))
+		err := instanceProfileAddRole(ctx, conn, d.Id(), v.(string), resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -221,7 +225,7 @@ func resourceInstanceProfileUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if n := n.(string); n != "" {
-			err := instanceProfileAddRole(ctx, conn, d.Id(), n)
+			err := instanceProfileAddRole(ctx, conn, d.Id(), n, providerPropagationTimeout(ctx, meta))
 
 			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
@@ -260,13 +264,13 @@ func resourceInstanceProfileDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func instanceProfileAddRole(ctx context.Context, conn *iam.Client, profileName, roleName string) error {
+func instanceProfileAddRole(ctx context.Context, conn *iam.Client, profileName, roleName string, timeout time.Duration) error {
 	input := &iam.AddRoleToInstanceProfileInput{
 		InstanceProfileName: aws.String(profileName),
 		RoleName:            aws.String(roleName),
 	}
 
-	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+	_, err := tfresource.RetryWhen(ctx, timeout,
 		func() (interface{}, error) {
 			return conn.AddRoleToInstanceProfile(ctx, input)
 		},
@@ -5,12 +5,20 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,6 +32,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	openIDConfigurationMaxLen = 1000000
+)
+
 // @SDKResource("aws_iam_openid_connect_provider", name="OIDC Provider")
 // @Tags(identifierAttribute="id", resourceType="OIDCProvider")
 // @Testing(name="OpenIDConnectProvider")
@@ -51,11 +63,18 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 255),
 				},
 			},
+			"compute_thumbprint": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"thumbprint_list"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbprint_list": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"compute_thumbprint"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -70,7 +89,10 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceOpenIDConnectProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -79,10 +101,22 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	input := &iam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
-		Tags:           getTagsIn(ctx),
-		ThumbprintList: flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
-		Url:            aws.String(d.Get(names.AttrURL).(string)),
+		ClientIDList: flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		Url:          aws.String(d.Get(names.AttrURL).(string)),
+	}
+
+	if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 {
+		input.ThumbprintList = flex.ExpandStringValueList(v.([]interface{}))
+	} else if d.Get("compute_thumbprint").(bool) {
+		// The issuer URL was not known during plan.
+		thumbprint, err := openIDConnectProviderThumbprint(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), d.Get(names.AttrURL).(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM OIDC Provider: %s", err)
+		}
+
+		input.ThumbprintList = []string{thumbprint}
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -217,6 +251,112 @@ func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+// resourceOpenIDConnectProviderCustomizeDiff calculates the thumbprint from the identity provider's TLS certificate chain
+// if compute_thumbprint is set, so that a change of certificate authority results in an update.
+func resourceOpenIDConnectProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("compute_thumbprint").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrURL) {
+		return d.SetNewComputed("thumbprint_list")
+	}
+
+	thumbprint, err := openIDConnectProviderThumbprint(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), d.Get(names.AttrURL).(string))
+
+	if err != nil {
+		return err
+	}
+
+	if o, _ := d.GetChange("thumbprint_list"); len(o.([]interface{})) == 1 && o.([]interface{})[0].(string) == thumbprint {
+		return nil
+	}
+
+	return d.SetNew("thumbprint_list", []string{thumbprint})
+}
+
+// openIDConnectProviderThumbprint returns the thumbprint of the top intermediate certificate authority
+// in the certificate chain of the identity provider's JWKS endpoint.
+// Requests are made with the provider's HTTP client so that proxy, custom CA bundle and insecure settings apply.
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func openIDConnectProviderThumbprint(ctx context.Context, client *http.Client, issuerURL string) (string, error) {
+	if !strings.HasPrefix(issuerURL, "https://") {
+		issuerURL = "https://" + issuerURL
+	}
+
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	response, err := openIDConnectProviderGet(ctx, client, configurationURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, openIDConfigurationMaxLen+1))
+
+	if err != nil {
+		return "", fmt.Errorf("reading response body (%s): %w", configurationURL, err)
+	}
+
+	if len(body) > openIDConfigurationMaxLen {
+		return "", fmt.Errorf("reading response body (%s): document exceeds the maximum size of %d bytes", configurationURL, openIDConfigurationMaxLen)
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return "", fmt.Errorf("parsing OpenID configuration (%s): %w", configurationURL, err)
+	}
+
+	u, err := url.Parse(configuration.JWKSURI)
+
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return "", fmt.Errorf("OpenID configuration (%s): invalid jwks_uri (%s)", configurationURL, configuration.JWKSURI)
+	}
+
+	response, err = openIDConnectProviderGet(ctx, client, u.String())
+
+	if err != nil {
+		return "", err
+	}
+
+	// Only the certificate chain is of interest.
+	response.Body.Close()
+
+	if response.TLS == nil || len(response.TLS.PeerCertificates) == 0 {
+		return "", fmt.Errorf("HTTP GET (%s): no certificates", u)
+	}
+
+	certificates := response.TLS.PeerCertificates
+
+	return fmt.Sprintf("%x", sha1.Sum(certificates[len(certificates)-1].Raw)), nil // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-sha1
+}
+
+func openIDConnectProviderGet(ctx context.Context, client *http.Client, requestURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET (%s): %w", requestURL, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+
+		return nil, fmt.Errorf("HTTP GET (%s): unexpected HTTP status %s", requestURL, response.Status)
+	}
+
+	return response, nil
+}
+
 func findOpenIDConnectProviderByARN(ctx context.Context, conn *iam.Client, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
 	input := &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(arn),
@@ -5,9 +5,15 @@ package iam_test
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -167,6 +173,99 @@ func TestAccIAMOpenIDConnectProvider_clientIDModification(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_computeThumbprint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_computeThumbprint,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_thumbprint", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", acctest.Ct1),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexache.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMOpenIDConnectProvider_thumbprintOmitted(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_thumbprintOmitted,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_thumbprint", acctest.CtFalse),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexache.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+			{
+				Config:   testAccOpenIDConnectProviderConfig_thumbprintOmitted,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{"jwks_uri": server.URL + "/keys"}) //nolint:errcheck // test server
+		case "/oversized/.well-known/openid-configuration":
+			fmt.Fprint(w, strings.Repeat(" ", 1000001))
+		case "/insecure/.well-known/openid-configuration":
+			fmt.Fprint(w, `{"jwks_uri":"http://example.com/keys"}`)
+		case "/keys":
+			fmt.Fprint(w, `{"keys":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	got, err := tfiam.OpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := fmt.Sprintf("%x", sha1.Sum(server.Certificate().Raw)); got != want { // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-sha1
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	for _, path := range []string{"/missing", "/oversized", "/insecure"} {
+		if _, err := tfiam.OpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL+path); err == nil {
+			t.Errorf("%s: expected error, got none", path)
+		}
+	}
+
+	// The server's self-signed certificate is not trusted by a default client.
+	if _, err := tfiam.OpenIDConnectProviderThumbprint(ctx, &http.Client{}, server.URL); err == nil {
+		t.Error("untrusted certificate: expected error, got none")
+	}
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -304,3 +403,20 @@ resource "aws_iam_openid_connect_provider" "test" {
 }
 `, rName)
 }
+
+// Thumbprint calculation requires a reachable identity provider.
+const testAccOpenIDConnectProviderConfig_computeThumbprint = `
+resource "aws_iam_openid_connect_provider" "test" {
+  url                = "https://accounts.google.com"
+  client_id_list     = ["266362248691-re108qaeld573ia0l6clj2i5ac7r7291.apps.googleusercontent.com"]
+  compute_thumbprint = true
+}
+`
+
+// IAM obtains the thumbprint from the identity provider when none is supplied.
+const testAccOpenIDConnectProviderConfig_thumbprintOmitted = `
+resource "aws_iam_openid_connect_provider" "test" {
+  url            = "https://accounts.google.com"
+  client_id_list = ["266362248691-re108qaeld573ia0l6clj2i5ac7r7291.apps.googleusercontent.com"]
+}
+`
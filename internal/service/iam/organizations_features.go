@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_organizations_features", name="Organizations Features")
+func newOrganizationsFeaturesResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &organizationsFeaturesResource{}, nil
+}
+
+type organizationsFeaturesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*organizationsFeaturesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_organizations_features"
+}
+
+func (r *organizationsFeaturesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled_features": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						enum.FrameworkValidate[awstypes.FeatureType](),
+					),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *organizationsFeaturesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationsFeaturesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if err := updateOrganizationsFeatures(ctx, conn, fwflex.ExpandFrameworkStringValueSet(ctx, data.EnabledFeatures), nil); err != nil {
+		response.Diagnostics.AddError("creating IAM Organizations Features", err.Error())
+
+		return
+	}
+
+	output, err := findOrganizationsFeatures(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IAM Organizations Features", err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.OrganizationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationsFeaturesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationsFeaturesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	output, err := findOrganizationsFeatures(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Organizations Features (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.EnabledFeatures = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, enum.Slice(output.EnabledFeatures...))
+	data.ID = fwflex.StringToFramework(ctx, output.OrganizationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationsFeaturesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new organizationsFeaturesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if err := updateOrganizationsFeatures(ctx, conn, fwflex.ExpandFrameworkStringValueSet(ctx, new.EnabledFeatures), fwflex.ExpandFrameworkStringValueSet(ctx, old.EnabledFeatures)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Organizations Features (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *organizationsFeaturesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationsFeaturesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if err := updateOrganizationsFeatures(ctx, conn, nil, fwflex.ExpandFrameworkStringValueSet(ctx, data.EnabledFeatures)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IAM Organizations Features (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// updateOrganizationsFeatures enables features in `new` that are not in `old` and disables features in `old` that are not in `new`.
+func updateOrganizationsFeatures(ctx context.Context, conn *iam.Client, new, old []string) error {
+	toEnable, toDisable, _ := flex.DiffSlices(old, new, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, v := range toEnable {
+		var err error
+
+		switch awstypes.FeatureType(v) {
+		case awstypes.FeatureTypeRootCredentialsManagement:
+			_, err = conn.EnableOrganizationsRootCredentialsManagement(ctx, &iam.EnableOrganizationsRootCredentialsManagementInput{})
+		case awstypes.FeatureTypeRootSessions:
+			_, err = conn.EnableOrganizationsRootSessions(ctx, &iam.EnableOrganizationsRootSessionsInput{})
+		default:
+			err = fmt.Errorf("unsupported feature")
+		}
+
+		if err != nil {
+			return fmt.Errorf("enabling IAM Organizations feature (%s): %w", v, err)
+		}
+	}
+
+	for _, v := range toDisable {
+		var err error
+
+		switch awstypes.FeatureType(v) {
+		case awstypes.FeatureTypeRootCredentialsManagement:
+			_, err = conn.DisableOrganizationsRootCredentialsManagement(ctx, &iam.DisableOrganizationsRootCredentialsManagementInput{})
+		case awstypes.FeatureTypeRootSessions:
+			_, err = conn.DisableOrganizationsRootSessions(ctx, &iam.DisableOrganizationsRootSessionsInput{})
+		default:
+			err = fmt.Errorf("unsupported feature")
+		}
+
+		if err != nil {
+			return fmt.Errorf("disabling IAM Organizations feature (%s): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+func findOrganizationsFeatures(ctx context.Context, conn *iam.Client) (*iam.ListOrganizationsFeaturesOutput, error) {
+	input := &iam.ListOrganizationsFeaturesInput{}
+
+	output, err := conn.ListOrganizationsFeatures(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.EnabledFeatures) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type organizationsFeaturesResourceModel struct {
+	EnabledFeatures types.Set    `tfsdk:"enabled_features"`
+	ID              types.String `tfsdk:"id"`
+}
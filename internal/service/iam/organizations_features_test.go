@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Organizations features are account-wide, so these tests must not be run in parallel.
+func TestAccIAMOrganizationsFeatures_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_organizations_features.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationsFeaturesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationsFeaturesConfig_basic([]string{`"RootCredentialsManagement"`}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationsFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled_features.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_features.*", "RootCredentialsManagement"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationsFeaturesConfig_basic([]string{`"RootCredentialsManagement"`, `"RootSessions"`}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationsFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled_features.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_features.*", "RootCredentialsManagement"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_features.*", "RootSessions"),
+				),
+			},
+			{
+				Config: testAccOrganizationsFeaturesConfig_basic([]string{`"RootSessions"`}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationsFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled_features.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_features.*", "RootSessions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationsFeaturesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iam_organizations_features" {
+				continue
+			}
+
+			_, err := tfiam.FindOrganizationsFeatures(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IAM Organizations Features %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationsFeaturesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := tfiam.FindOrganizationsFeatures(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccOrganizationsFeaturesConfig_basic(features []string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_organizations_features" "test" {
+  enabled_features = [%[1]s]
+}
+`, strings.Join(features, ", "))
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"log"
 	"reflect"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -85,9 +86,10 @@ func resourcePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 		users = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	diags = sdkdiag.AppendFromErr(diags, attachPolicyToGroups(ctx, conn, groups, policyARN))
-	diags = sdkdiag.AppendFromErr(diags, attachPolicyToRoles(ctx, conn, roles, policyARN))
-	diags = sdkdiag.AppendFromErr(diags, attachPolicyToUsers(ctx, conn, users, policyARN))
+	timeout := providerPropagationTimeout(ctx, meta)
+	diags = sdkdiag.AppendFromErr(diags, attachPolicyToGroups(ctx, conn, groups, policyARN, timeout))
+	diags = sdkdiag.AppendFromErr(diags, attachPolicyToRoles(ctx, conn, roles, policyARN, timeout))
+	diags = sdkdiag.AppendFromErr(diags, attachPolicyToUsers(ctx, conn, users, policyARN, timeout))
 
 	if diags.HasError() {
 		return diags
@@ -127,13 +129,13 @@ func resourcePolicyAttachmentUpdate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	if d.HasChange("groups") {
-		diags = sdkdiag.AppendFromErr(diags, updateGroups(ctx, conn, d))
+		diags = sdkdiag.AppendFromErr(diags, updateGroups(ctx, conn, d, providerPropagationTimeout(ctx, meta)))
 	}
 	if d.HasChange("roles") {
-		diags = sdkdiag.AppendFromErr(diags, updateRoles(ctx, conn, d))
+		diags = sdkdiag.AppendFromErr(diags, updateRoles(ctx, conn, d, providerPropagationTimeout(ctx, meta)))
 	}
 	if d.HasChange("users") {
-		diags = sdkdiag.AppendFromErr(diags, updateUsers(ctx, conn, d))
+		diags = sdkdiag.AppendFromErr(diags, updateUsers(ctx, conn, d, providerPropagationTimeout(ctx, meta)))
 	}
 
 	if diags.HasError() {
@@ -159,116 +161,117 @@ func resourcePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData,
 		users = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromGroups(ctx, conn, groups, policyARN))
-	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromRoles(ctx, conn, roles, policyARN))
-	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromUsers(ctx, conn, users, policyARN))
+	timeout := providerPropagationTimeout(ctx, meta)
+	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromGroups(ctx, conn, groups, policyARN, timeout))
+	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromRoles(ctx, conn, roles, policyARN, timeout))
+	diags = sdkdiag.AppendFromErr(diags, detachPolicyFromUsers(ctx, conn, users, policyARN, timeout))
 
 	return diags
 }
 
-func attachPolicyToGroups(ctx context.Context, conn *iam.Client, groups []string, policyARN string) error {
+func attachPolicyToGroups(ctx context.Context, conn *iam.Client, groups []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, group := range groups {
-		errs = append(errs, attachPolicyToGroup(ctx, conn, group, policyARN))
+		errs = append(errs, attachPolicyToGroup(ctx, conn, group, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
 }
 
-func attachPolicyToRoles(ctx context.Context, conn *iam.Client, roles []string, policyARN string) error {
+func attachPolicyToRoles(ctx context.Context, conn *iam.Client, roles []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, role := range roles {
-		errs = append(errs, attachPolicyToRole(ctx, conn, role, policyARN))
+		errs = append(errs, attachPolicyToRole(ctx, conn, role, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
 }
 
-func attachPolicyToUsers(ctx context.Context, conn *iam.Client, users []string, policyARN string) error {
+func attachPolicyToUsers(ctx context.Context, conn *iam.Client, users []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, user := range users {
-		errs = append(errs, attachPolicyToUser(ctx, conn, user, policyARN))
+		errs = append(errs, attachPolicyToUser(ctx, conn, user, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
 }
 
-func updateGroups(ctx context.Context, conn *iam.Client, d *schema.ResourceData) error {
+func updateGroups(ctx context.Context, conn *iam.Client, d *schema.ResourceData, timeout time.Duration) error {
 	policyARN := d.Get("policy_arn").(string)
 	o, n := d.GetChange("groups")
 	os, ns := o.(*schema.Set), n.(*schema.Set)
 	add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
 
-	if err := detachPolicyFromGroups(ctx, conn, del, policyARN); err != nil {
+	if err := detachPolicyFromGroups(ctx, conn, del, policyARN, timeout); err != nil {
 		return err
 	}
-	if err := attachPolicyToGroups(ctx, conn, add, policyARN); err != nil {
+	if err := attachPolicyToGroups(ctx, conn, add, policyARN, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func updateRoles(ctx context.Context, conn *iam.Client, d *schema.ResourceData) error {
+func updateRoles(ctx context.Context, conn *iam.Client, d *schema.ResourceData, timeout time.Duration) error {
 	policyARN := d.Get("policy_arn").(string)
 	o, n := d.GetChange("roles")
 	os, ns := o.(*schema.Set), n.(*schema.Set)
 	add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
 
-	if err := detachPolicyFromRoles(ctx, conn, del, policyARN); err != nil {
+	if err := detachPolicyFromRoles(ctx, conn, del, policyARN, timeout); err != nil {
 		return err
 	}
-	if err := attachPolicyToRoles(ctx, conn, add, policyARN); err != nil {
+	if err := attachPolicyToRoles(ctx, conn, add, policyARN, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func updateUsers(ctx context.Context, conn *iam.Client, d *schema.ResourceData) error {
+func updateUsers(ctx context.Context, conn *iam.Client, d *schema.ResourceData, timeout time.Duration) error {
 	policyARN := d.Get("policy_arn").(string)
 	o, n := d.GetChange("users")
 	os, ns := o.(*schema.Set), n.(*schema.Set)
 	add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
 
-	if err := detachPolicyFromUsers(ctx, conn, del, policyARN); err != nil {
+	if err := detachPolicyFromUsers(ctx, conn, del, policyARN, timeout); err != nil {
 		return err
 	}
-	if err := attachPolicyToUsers(ctx, conn, add, policyARN); err != nil {
+	if err := attachPolicyToUsers(ctx, conn, add, policyARN, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func detachPolicyFromGroups(ctx context.Context, conn *iam.Client, groups []string, policyARN string) error {
+func detachPolicyFromGroups(ctx context.Context, conn *iam.Client, groups []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, group := range groups {
-		errs = append(errs, detachPolicyFromGroup(ctx, conn, group, policyARN))
+		errs = append(errs, detachPolicyFromGroup(ctx, conn, group, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
 }
 
-func detachPolicyFromRoles(ctx context.Context, conn *iam.Client, roles []string, policyARN string) error {
+func detachPolicyFromRoles(ctx context.Context, conn *iam.Client, roles []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, role := range roles {
-		errs = append(errs, detachPolicyFromRole(ctx, conn, role, policyARN))
+		errs = append(errs, detachPolicyFromRole(ctx, conn, role, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
 }
 
-func detachPolicyFromUsers(ctx context.Context, conn *iam.Client, users []string, policyARN string) error {
+func detachPolicyFromUsers(ctx context.Context, conn *iam.Client, users []string, policyARN string, timeout time.Duration) error {
 	var errs []error
 
 	for _, user := range users {
-		errs = append(errs, detachPolicyFromUser(ctx, conn, user, policyARN))
+		errs = append(errs, detachPolicyFromUser(ctx, conn, user, policyARN, timeout))
 	}
 
 	return errors.Join(errs...)
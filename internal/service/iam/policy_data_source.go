@@ -5,7 +5,11 @@ package iam
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
@@ -64,6 +68,65 @@ func dataSourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"statement": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"test": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrValues: {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"not_principals": dataSourcePolicyStatementPrincipalSchema(),
+						"not_resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principals": dataSourcePolicyStatementPrincipalSchema(),
+						names.AttrResources: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
 		},
 	}
@@ -78,7 +141,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	pathPrefix := d.Get("path_prefix").(string)
 
 	if arn == "" {
-		outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
+		outputRaw, err := tfresource.RetryWhenNotFound(ctx, providerPropagationTimeout(ctx, meta),
 			func() (interface{}, error) {
 				return findPolicyByTwoPartKey(ctx, conn, name, pathPrefix)
 			},
@@ -110,7 +173,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	setTagsOut(ctx, policy.Tags)
 
-	outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, providerPropagationTimeout(ctx, meta),
 		func() (interface{}, error) {
 			return findPolicyVersion(ctx, conn, arn, aws.ToString(policy.DefaultVersionId))
 		},
@@ -127,5 +190,153 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrPolicy, policyDocument)
 
+	statements, err := policyDocumentStatements(policyDocument)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing IAM Policy (%s) document: %s", arn, err)
+	}
+
+	if err := d.Set("statement", flattenPolicyStatements(statements)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statement: %s", err)
+	}
+
 	return diags
 }
+
+func dataSourcePolicyStatementPrincipalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"identifiers": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrType: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// policyDocumentStatements decodes the statements of a policy document.
+// A document's Statement element may be either a single statement or a list of statements.
+func policyDocumentStatements(document string) ([]*IAMPolicyStatement, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Statement) == 0 {
+		return nil, nil
+	}
+
+	var statements []*IAMPolicyStatement
+
+	if strings.HasPrefix(strings.TrimSpace(string(doc.Statement)), "{") {
+		statement := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(doc.Statement, statement); err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	} else if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
+
+func flattenPolicyStatements(apiObjects []*IAMPolicyStatement) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"actions":           policyStatementStrings(apiObject.Actions),
+			"condition":         flattenPolicyStatementConditions(apiObject.Conditions),
+			"effect":            apiObject.Effect,
+			"not_actions":       policyStatementStrings(apiObject.NotActions),
+			"not_principals":    flattenPolicyStatementPrincipals(apiObject.NotPrincipals),
+			"not_resources":     policyStatementStrings(apiObject.NotResources),
+			"principals":        flattenPolicyStatementPrincipals(apiObject.Principals),
+			names.AttrResources: policyStatementStrings(apiObject.Resources),
+			"sid":               apiObject.Sid,
+		})
+	}
+
+	return tfList
+}
+
+// flattenPolicyStatementPrincipals returns principals ordered by type, as JSON object members are unordered.
+func flattenPolicyStatementPrincipals(apiObjects IAMPolicyStatementPrincipalSet) []interface{} {
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortFunc(apiObjects, func(a, b IAMPolicyStatementPrincipal) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"identifiers":  policyStatementStrings(apiObject.Identifiers),
+			names.AttrType: apiObject.Type,
+		})
+	}
+
+	return tfList
+}
+
+// flattenPolicyStatementConditions returns conditions ordered by test and variable, as JSON object members are unordered.
+func flattenPolicyStatementConditions(apiObjects IAMPolicyStatementConditionSet) []interface{} {
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortFunc(apiObjects, func(a, b IAMPolicyStatementCondition) int {
+		if v := strings.Compare(a.Test, b.Test); v != 0 {
+			return v
+		}
+
+		return strings.Compare(a.Variable, b.Variable)
+	})
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"test":           apiObject.Test,
+			names.AttrValues: policyStatementStrings(apiObject.Values),
+			"variable":       apiObject.Variable,
+		})
+	}
+
+	return tfList
+}
+
+// policyStatementStrings returns a policy element that may be a single value or a list of values as a list of strings.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		tfList := make([]string, 0, len(v))
+		for _, v := range v {
+			tfList = append(tfList, fmt.Sprint(v))
+		}
+		return tfList
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -5,12 +5,14 @@ package iam_test
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -36,12 +38,107 @@ func TestAccIAMPolicyDataSource_arn(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
 					resource.TestCheckResourceAttrPair(datasourceName, "attachment_count", resourceName, "attachment_count"),
 					resource.TestCheckResourceAttrPair(datasourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttr(datasourceName, "statement.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(datasourceName, "statement.0.actions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(datasourceName, "statement.0.actions.0", "ec2:Describe*"),
+					resource.TestCheckResourceAttr(datasourceName, "statement.0.effect", "Allow"),
+					resource.TestCheckResourceAttr(datasourceName, "statement.0.resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(datasourceName, "statement.0.resources.0", "*"),
 				),
 			},
 		},
 	})
 }
 
+func TestPolicyDocumentStatements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		expected []interface{}
+		wantErr  bool
+	}{
+		"single statement": {
+			document: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"actions":        []string{"s3:GetObject"},
+					"condition":      []interface{}{},
+					"effect":         "Allow",
+					"not_actions":    []string(nil),
+					"not_principals": []interface{}{},
+					"not_resources":  []string(nil),
+					"principals":     []interface{}{},
+					"resources":      []string{"*"},
+					"sid":            "",
+				},
+			},
+		},
+		"principals and conditions": {
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Trust",
+    "Effect": "Allow",
+    "Action": ["sts:AssumeRole", "sts:TagSession"],
+    "Principal": {"Service": "ec2.amazonaws.com", "AWS": ["arn:aws:iam::123456789012:root"]},
+    "Condition": {
+      "StringEquals": {"sts:ExternalId": "abc"},
+      "NumericLessThan": {"aws:MultiFactorAuthAge": 3600},
+      "Bool": {"aws:SecureTransport": true}
+    }
+  }]
+}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"actions": []string{"sts:AssumeRole", "sts:TagSession"},
+					"condition": []interface{}{
+						map[string]interface{}{"test": "Bool", "values": []string{acctest.CtTrue}, "variable": "aws:SecureTransport"},
+						map[string]interface{}{"test": "NumericLessThan", "values": []string{"3600"}, "variable": "aws:MultiFactorAuthAge"},
+						map[string]interface{}{"test": "StringEquals", "values": []string{"abc"}, "variable": "sts:ExternalId"},
+					},
+					"effect":         "Allow",
+					"not_actions":    []string(nil),
+					"not_principals": []interface{}{},
+					"not_resources":  []string(nil),
+					"principals": []interface{}{
+						map[string]interface{}{"identifiers": []string{"arn:aws:iam::123456789012:root"}, "type": "AWS"},
+						map[string]interface{}{"identifiers": []string{"ec2.amazonaws.com"}, "type": "Service"},
+					},
+					"resources": []string(nil),
+					"sid":       "Trust",
+				},
+			},
+		},
+		"invalid JSON": {
+			document: `{"Statement":`,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			statements, err := tfiam.PolicyDocumentStatements(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("PolicyDocumentStatements() err = %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := tfiam.FlattenPolicyStatements(statements), testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("FlattenPolicyStatements() = %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
 func TestAccIAMPolicyDataSource_name(t *testing.T) {
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_iam_policy.test"
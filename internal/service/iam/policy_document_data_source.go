@@ -60,6 +60,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 			}
 
 			return map[string]*schema.Schema{
+				"fail_on_conflict": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				names.AttrJSON: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -68,6 +73,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minify": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -167,6 +177,29 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	mergedDoc := &IAMPolicyDoc{}
 
+	// Conflicts are reported only if fail_on_conflict is set so that existing configurations,
+	// which rely on overriding by Sid and tolerate duplicate statements, are unaffected.
+	failOnConflict := d.Get("fail_on_conflict").(bool)
+	checkConflicts := func(newDoc *IAMPolicyDoc, name string) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if !failOnConflict {
+			return diags
+		}
+
+		sids, duplicates := mergedDoc.Conflicts(newDoc)
+
+		for _, sid := range sids {
+			diags = sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging %s: Sid (%s) conflicts with an existing statement. Remove the Sid, ensure Sids are unique or unset fail_on_conflict.", name, sid)
+		}
+
+		for _, i := range duplicates {
+			diags = sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging %s: statement %d duplicates an existing statement", name, i)
+		}
+
+		return diags
+	}
+
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
 		// generate sid map to assure there are no duplicates in source jsons
 		sidMap := make(map[string]struct{})
@@ -197,6 +230,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				}
 			}
 
+			diags = append(diags, checkConflicts(sourceDoc, fmt.Sprintf("source document %d", sourceJSONIndex))...)
+			if diags.HasError() {
+				return diags
+			}
+
 			mergedDoc.Merge(sourceDoc)
 		}
 	}
@@ -287,6 +325,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		doc.Statements = stmts
 	}
 
+	diags = append(diags, checkConflicts(doc, "statement")...)
+	if diags.HasError() {
+		return diags
+	}
+
 	// merge our current document into mergedDoc
 	mergedDoc.Merge(doc)
 
@@ -301,6 +344,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
 
+			diags = append(diags, checkConflicts(overrideDoc, fmt.Sprintf("override document %d", overrideJSONIndex))...)
+			if diags.HasError() {
+				return diags
+			}
+
 			mergedDoc.Merge(overrideDoc)
 		}
 	}
@@ -312,8 +360,6 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
-	d.Set(names.AttrJSON, jsonString)
-
 	jsonMinDoc, err := json.Marshal(mergedDoc)
 	if err != nil {
 		// should never happen if the above code is correct
@@ -321,6 +367,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonMinString := string(jsonMinDoc)
 
+	if d.Get("minify").(bool) {
+		jsonString = jsonMinString
+	}
+
+	d.Set(names.AttrJSON, jsonString)
 	d.Set("minified_json", jsonMinString)
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
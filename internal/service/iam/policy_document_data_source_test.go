@@ -296,6 +296,53 @@ func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_failOnConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_failOnConflict(acctest.CtTrue, "Override"),
+				ExpectError: regexache.MustCompile(`Sid \(Override\) conflicts with an existing statement`),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_failOnConflict(acctest.CtTrue, ""),
+				ExpectError: regexache.MustCompile(`statement 0 duplicates an existing statement`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_failOnConflict(acctest.CtFalse, "Override"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", names.AttrJSON,
+						testAccPolicyDocumentFailOnConflictExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, testAccPolicyDocumentMinifyExpectedJSON),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrJSON, dataSourceName, "minified_json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_sourcePolicyValidJSON(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1314,6 +1361,55 @@ var testAccPolicyDocumentDuplicateBlankSidExpectedJSON = `{
   ]
 }`
 
+func testAccPolicyDocumentDataSourceConfig_failOnConflict(failOnConflict, sid string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "override" {
+  statement {
+    sid       = %[2]q
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  fail_on_conflict          = %[1]s
+  override_policy_documents = [data.aws_iam_policy_document.override.json]
+
+  statement {
+    sid       = "Override"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`, failOnConflict, sid)
+}
+
+var testAccPolicyDocumentFailOnConflictExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Override",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "test" {
+  minify = true
+
+  statement {
+    sid       = "1"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccPolicyDocumentMinifyExpectedJSON = `{"Version":"2012-10-17","Statement":[{"Sid":"1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
 const testAccPolicyDocumentDataSourceConfig_version20081017 = `
 data "aws_iam_policy_document" "test" {
   version = "2008-10-17"
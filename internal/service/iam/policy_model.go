@@ -6,6 +6,7 @@ package iam
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 
@@ -82,6 +83,52 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// Conflicts returns the Sids of statements in newDoc that would replace existing statements when merged
+// and the indexes of statements in newDoc that duplicate (ignoring Sid) an existing statement.
+// A statement that replaces an existing statement by Sid is never reported as a duplicate,
+// as Merge removes the statement that it replaces.
+func (s *IAMPolicyDoc) Conflicts(newDoc *IAMPolicyDoc) ([]string, []int) {
+	var sids []string
+	var duplicates []int
+
+	existing := make(map[string]struct{}, len(s.Statements))
+	for _, existingStatement := range s.Statements {
+		if key, err := existingStatement.contentKey(); err == nil {
+			existing[key] = struct{}{}
+		}
+	}
+
+	for i, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) > 0 && slices.ContainsFunc(s.Statements, func(v *IAMPolicyStatement) bool {
+			return v.Sid == newStatement.Sid
+		}) {
+			sids = append(sids, newStatement.Sid)
+			continue
+		}
+
+		if key, err := newStatement.contentKey(); err == nil {
+			if _, ok := existing[key]; ok {
+				duplicates = append(duplicates, i)
+			}
+		}
+	}
+
+	return sids, duplicates
+}
+
+// contentKey returns the statement's JSON form without its Sid, for duplicate detection.
+func (s *IAMPolicyStatement) contentKey() (string, error) {
+	stmt := *s
+	stmt.Sid = ""
+
+	b, err := json.Marshal(stmt)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -220,10 +267,19 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case bool:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatFloat(var_values, 'f', -1, 64)})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					switch v := v.(type) {
+					case string:
+						values = append(values, v)
+					case bool:
+						values = append(values, strconv.FormatBool(v))
+					case float64:
+						values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+					}
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
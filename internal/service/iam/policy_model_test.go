@@ -394,6 +394,68 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 	}
 }
 
+func TestIAMPolicyStatementConditionSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		in      string
+		want    tfiam.IAMPolicyStatementConditionSet
+		wantErr bool
+	}{
+		"string": {
+			in: `{"StringEquals": {"aws:username": "johndoe"}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "StringEquals", Variable: "aws:username", Values: []string{"johndoe"}},
+			},
+		},
+		"bool": {
+			in: `{"Bool": {"aws:SecureTransport": false}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+			},
+		},
+		"number": {
+			in: `{"NumericLessThanEquals": {"s3:max-keys": 10}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericLessThanEquals", Variable: "s3:max-keys", Values: "10"},
+			},
+		},
+		"fractional number": {
+			in: `{"NumericGreaterThan": {"aws:MultiFactorAuthAge": 1.5}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericGreaterThan", Variable: "aws:MultiFactorAuthAge", Values: "1.5"},
+			},
+		},
+		"mixed list": {
+			in: `{"ForAnyValue:StringEquals": {"aws:TagKeys": ["environment", true, 3600]}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"environment", "true", "3600"}},
+			},
+		},
+		"invalid": {
+			in:      `{"StringEquals": "johndoe"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got tfiam.IAMPolicyStatementConditionSet
+			err := json.Unmarshal([]byte(tc.in), &got)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("IAMPolicyStatementConditionSet.UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("IAMPolicyStatementConditionSet.UnmarshalJSON() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 	t.Parallel()
 
@@ -431,3 +493,66 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyDocConflicts(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	existing := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "One", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}
+  ]
+}`
+
+	testCases := map[string]struct {
+		newDoc         string
+		wantSids       []string
+		wantDuplicates []int
+	}{
+		"no conflicts": {
+			newDoc: `{"Statement": [{"Sid": "Two", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}]}`,
+		},
+		"same Sid": {
+			newDoc:   `{"Statement": [{"Sid": "One", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			wantSids: []string{"One"},
+		},
+		"same Sid and content": {
+			newDoc:   `{"Statement": [{"Sid": "One", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			wantSids: []string{"One"},
+		},
+		"duplicate without Sid": {
+			newDoc:         `{"Statement": [{"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}, {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			wantDuplicates: []int{1},
+		},
+		"duplicate with different Sid": {
+			newDoc:         `{"Statement": [{"Sid": "Two", "Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}]}`,
+			wantDuplicates: []int{0},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc, newDoc tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(existing), &doc); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tc.newDoc), &newDoc); err != nil {
+				t.Fatal(err)
+			}
+
+			gotSids, gotDuplicates := doc.Conflicts(&newDoc)
+
+			if !reflect.DeepEqual(gotSids, tc.wantSids) {
+				t.Errorf("IAMPolicyDoc.Conflicts() Sids = %v, want %v", gotSids, tc.wantSids)
+			}
+			if !reflect.DeepEqual(gotDuplicates, tc.wantDuplicates) {
+				t.Errorf("IAMPolicyDoc.Conflicts() duplicates = %v, want %v", gotDuplicates, tc.wantDuplicates)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_policy_simulation", name="Policy Simulation")
+func dataSourcePolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "iam:CreateUser", that should be included in the simulation.`,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of an IAM user to use as the caller of the simulated requests. Required if resource_policy_json is specified.`,
+			},
+			"context": policySimulationContextSchema(),
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permission boundary policies to use in the simulation.`,
+			},
+			"policies_json": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies to use in the simulation. The simulation behaves as though a principal had exactly these policies attached.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions during simulation. If not specified, the simulator assumes "*" which represents general access across all resources.`,
+			},
+			"resource_handling_option": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the type of simulation to run. Some API operations need a particular resource handling option in order to produce a correct result.`,
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+				Description:  `An AWS account ID to use as the simulated owner for any resource whose ARN does not include a specific owner account ID. Defaults to the account given as part of caller_arn.`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `A resource policy to associate with all of the target resources for simulation purposes.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": policySimulationResultsSchema(),
+			names.AttrID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Do not use`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	input := &iam.SimulateCustomPolicyInput{
+		ActionNames:                        expandPolicySimulationStringSet(d.Get("action_names")),
+		ContextEntries:                     expandPolicySimulationContextEntries(d.Get("context").(*schema.Set).List()),
+		PermissionsBoundaryPolicyInputList: expandPolicySimulationStringSet(d.Get("permissions_boundary_policies_json")),
+		PolicyInputList:                    expandPolicySimulationStringSet(d.Get("policies_json")),
+		ResourceArns:                       expandPolicySimulationStringSet(d.Get("resource_arns")),
+		// Ask for as much as possible in each request to minimize round-trips.
+		MaxItems: aws.Int32(1000),
+	}
+
+	if v := d.Get("caller_arn").(string); v != "" {
+		input.CallerArn = aws.String(v)
+	}
+	if v := d.Get("resource_handling_option").(string); v != "" {
+		input.ResourceHandlingOption = aws.String(v)
+	}
+	if v := d.Get("resource_owner_account_id").(string); v != "" {
+		input.ResourceOwner = aws.String(v)
+	}
+	if v := d.Get("resource_policy_json").(string); v != "" {
+		input.ResourcePolicy = aws.String(v)
+	}
+
+	var results []awstypes.EvaluationResult
+
+	pages := iam.NewSimulateCustomPolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "simulating IAM Custom Policy: %s", err)
+		}
+
+		results = append(results, page.EvaluationResults...)
+	}
+
+	rawResults, allAllowed := flattenPolicySimulationResults(results)
+	d.Set("results", rawResults)
+	d.Set("all_allowed", allAllowed)
+
+	d.SetId("-")
+
+	return diags
+}
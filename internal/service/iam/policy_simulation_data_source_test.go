@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicySimulationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	allowDataSourceName := "data.aws_iam_policy_simulation.allow"
+	denyExplicitDataSourceName := "data.aws_iam_policy_simulation.deny_explicit"
+	denyImplicitDataSourceName := "data.aws_iam_policy_simulation.deny_implicit"
+	mixedDataSourceName := "data.aws_iam_policy_simulation.multiple_mixed"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allowDataSourceName, "all_allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.0.allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.0.matched_statements.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(allowDataSourceName, "results.0.matched_statements.0.source_policy_id", "PolicyInputList.1"),
+
+					resource.TestCheckResourceAttr(denyExplicitDataSourceName, "all_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(denyExplicitDataSourceName, "results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(denyExplicitDataSourceName, "results.0.action_name", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr(denyExplicitDataSourceName, "results.0.allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(denyExplicitDataSourceName, "results.0.decision", "explicitDeny"),
+
+					resource.TestCheckResourceAttr(denyImplicitDataSourceName, "all_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(denyImplicitDataSourceName, "results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(denyImplicitDataSourceName, "results.0.action_name", "ec2:RunInstances"),
+					resource.TestCheckResourceAttr(denyImplicitDataSourceName, "results.0.allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(denyImplicitDataSourceName, "results.0.decision", "implicitDeny"),
+
+					resource.TestCheckResourceAttr(mixedDataSourceName, "all_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(mixedDataSourceName, "results.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicySimulationDataSourceConfig_basic = `
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      },
+      {
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+    ]
+  })
+}
+
+data "aws_iam_policy_simulation" "allow" {
+  action_names  = ["s3:GetObject"]
+  policies_json = [local.policy]
+}
+
+data "aws_iam_policy_simulation" "deny_explicit" {
+  action_names  = ["s3:DeleteObject"]
+  policies_json = [local.policy]
+}
+
+data "aws_iam_policy_simulation" "deny_implicit" {
+  action_names  = ["ec2:RunInstances"]
+  policies_json = [local.policy]
+}
+
+data "aws_iam_policy_simulation" "multiple_mixed" {
+  action_names  = ["s3:GetObject", "s3:DeleteObject"]
+  policies_json = [local.policy]
+}
+`
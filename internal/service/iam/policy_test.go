@@ -349,6 +349,36 @@ func TestAccIAMPolicy_policyDuplicateKeys(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AccessAnalyzerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_validatePolicy(rName, "iam:PassRole"),
+				ExpectError: regexache.MustCompile(`PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccPolicyConfig_validatePolicy(rName, "ec2:DescribeInstances"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyExists(ctx context.Context, n string, v *awstypes.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -526,6 +556,24 @@ resource "aws_iam_policy" "test" {
 `, rName, policy)
 }
 
+func testAccPolicyConfig_validatePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name            = %[1]q
+  validate_policy = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = %[2]q
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
+
 func testAccPolicyConfig_diffs(rName string, tags string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
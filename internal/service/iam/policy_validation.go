@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IAM policy size quotas, in characters.
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+const (
+	policySizeQuotaGroupInline = 5120
+	policySizeQuotaManaged     = 6144
+	policySizeQuotaRoleInline  = 10240
+	policySizeQuotaUserInline  = 2048
+
+	// The role trust policy quota defaults to 2048 characters and can be increased to 4096,
+	// so only the maximum is enforced at plan time.
+	policySizeQuotaRoleTrustMax = 4096
+)
+
+// customizeDiffValidatePolicy runs the planned identity policy document through
+// IAM Access Analyzer policy validation when `validate_policy` is enabled.
+// Error and security warning findings fail the plan; other findings are logged.
+func customizeDiffValidatePolicy(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_policy").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrPolicy, "validate_policy") {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	findings, err := findPolicyValidationFindings(ctx, conn, d.Get(names.AttrPolicy).(string), accessanalyzertypes.PolicyTypeIdentityPolicy)
+
+	if err != nil {
+		return fmt.Errorf("validating %s: %w", names.AttrPolicy, err)
+	}
+
+	var findingErrs []error
+
+	for _, v := range findings {
+		switch v.FindingType {
+		case accessanalyzertypes.ValidatePolicyFindingTypeError, accessanalyzertypes.ValidatePolicyFindingTypeSecurityWarning:
+			findingErrs = append(findingErrs, policyValidationFindingError(v))
+		default:
+			tflog.Warn(ctx, "IAM Access Analyzer policy validation finding", map[string]any{
+				"finding_type":    v.FindingType,
+				"issue_code":      aws.ToString(v.IssueCode),
+				"finding_details": aws.ToString(v.FindingDetails),
+				"learn_more_link": aws.ToString(v.LearnMoreLink),
+			})
+		}
+	}
+
+	if len(findingErrs) > 0 {
+		return fmt.Errorf("%s failed IAM Access Analyzer validation: %w", names.AttrPolicy, errors.Join(findingErrs...))
+	}
+
+	return nil
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, policy string, policyType accessanalyzertypes.PolicyType) ([]accessanalyzertypes.ValidatePolicyFinding, error) {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     policyType,
+	}
+	var output []accessanalyzertypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func policyValidationFindingError(v accessanalyzertypes.ValidatePolicyFinding) error {
+	return fmt.Errorf("%s %s: %s (%s)", v.FindingType, aws.ToString(v.IssueCode), aws.ToString(v.FindingDetails), aws.ToString(v.LearnMoreLink))
+}
+
+// customizeDiffPolicySize returns a CustomizeDiffFunc that fails the plan when the normalized
+// policy document is larger than the specified IAM policy size quota.
+// Inline policy quotas apply to the aggregate size of all of an entity's inline policies,
+// so a document under the quota can still be rejected at apply time.
+func customizeDiffPolicySize(policyType string, quota int) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !d.NewValueKnown(names.AttrPolicy) {
+			return nil
+		}
+
+		size, err := policySize(d.Get(names.AttrPolicy).(string))
+
+		if err != nil {
+			// Invalid JSON is reported by the attribute's ValidateFunc.
+			return nil
+		}
+
+		if size > quota {
+			return policySizeError(names.AttrPolicy, policyType, size, quota)
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffRolePolicySize fails the plan when an aws_iam_role's trust policy, or the combined
+// size of its `inline_policy` documents, is larger than the corresponding IAM policy size quota.
+func customizeDiffRolePolicySize(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.NewValueKnown("assume_role_policy") {
+		if size, err := policySize(d.Get("assume_role_policy").(string)); err == nil && size > policySizeQuotaRoleTrustMax {
+			return policySizeError("assume_role_policy", "role trust", size, policySizeQuotaRoleTrustMax)
+		}
+	}
+
+	if d.NewValueKnown("inline_policy") {
+		var total int
+
+		for _, tfMapRaw := range d.Get("inline_policy").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if size, err := policySize(tfMap[names.AttrPolicy].(string)); err == nil {
+				total += size
+			}
+		}
+
+		if total > policySizeQuotaRoleInline {
+			return policySizeError("inline_policy", "role inline", total, policySizeQuotaRoleInline)
+		}
+	}
+
+	return nil
+}
+
+func policySizeError(attr, policyType string, size, quota int) error {
+	return fmt.Errorf("%s is %d characters (excluding white space), which exceeds the IAM %s policy size quota of %d characters by %d; reduce the number of statements, actions or resources, or split the document into multiple policies", attr, size, policyType, quota, size-quota)
+}
+
+// policySize returns the size of the policy document as counted against IAM quotas.
+// IAM doesn't count white space when calculating the size of a policy, but white space
+// inside JSON string values is part of the document and is counted.
+func policySize(policy string) (int, error) {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, []byte(policy)); err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCount(buf.Bytes()), nil
+}
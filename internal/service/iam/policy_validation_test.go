@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+)
+
+func TestPolicySize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy    string
+		wantSize  int
+		wantError bool
+	}{
+		"compact": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+			wantSize: 86,
+		},
+		"white space ignored": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "s3:*",
+    "Resource": "*"
+  }
+}`,
+			wantSize: 86,
+		},
+		"version reordered": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*"},"Version":"2012-10-17"}`,
+			wantSize: 86,
+		},
+		"white space in values counted": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Sid":"Allow all S3","Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+			wantSize: 107,
+		},
+		"invalid JSON": {
+			policy:    `{"Version":`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := policySize(testCase.policy)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("policySize() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && got != testCase.wantSize {
+				t.Errorf("policySize() = %d, want %d", got, testCase.wantSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_principal_access_last_used", name="Principal Access Last Used")
+func dataSourcePrincipalAccessLastUsed() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrincipalAccessLastUsedRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"granularity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.AccessAdvisorUsageGranularityTypeServiceLevel,
+				ValidateDiagFunc: enum.Validate[awstypes.AccessAdvisorUsageGranularityType](),
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services_last_accessed": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tracked_actions_last_accessed": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_entity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalAccessLastUsedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	input := &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(arn),
+		Granularity: awstypes.AccessAdvisorUsageGranularityType(d.Get("granularity").(string)),
+	}
+
+	output, err := conn.GenerateServiceLastAccessedDetails(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM service last accessed details (%s): %s", arn, err)
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	if err := waitServiceLastAccessedDetailsJobCompleted(ctx, conn, jobID, d.Timeout(schema.TimeoutRead)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM service last accessed details (%s) job (%s): %s", arn, jobID, err)
+	}
+
+	details, services, err := findServiceLastAccessedDetailsByJobID(ctx, conn, jobID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM service last accessed details (%s) job (%s): %s", arn, jobID, err)
+	}
+
+	d.SetId(arn)
+	d.Set("job_completion_date", aws.ToTime(details.JobCompletionDate).Format(time.RFC3339))
+	d.Set("job_id", jobID)
+	if err := d.Set("services_last_accessed", flattenServicesLastAccessed(services)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services_last_accessed: %s", err)
+	}
+
+	return diags
+}
+
+// waitServiceLastAccessedDetailsJobCompleted waits for a service last accessed details job to finish.
+func waitServiceLastAccessedDetailsJobCompleted(ctx context.Context, conn *iam.Client, jobID string, timeout time.Duration) error {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+
+	_, err := tfresource.RetryUntilEqual(ctx, timeout, awstypes.JobStatusTypeCompleted, func() (awstypes.JobStatusType, error) {
+		output, err := conn.GetServiceLastAccessedDetails(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		if output.JobStatus == awstypes.JobStatusTypeFailed {
+			if output.Error != nil {
+				return "", errors.New(aws.ToString(output.Error.Message))
+			}
+
+			return "", fmt.Errorf("job %s", output.JobStatus)
+		}
+
+		return output.JobStatus, nil
+	})
+
+	return err
+}
+
+// findServiceLastAccessedDetailsByJobID returns the first page of a completed job's output along with
+// the services accessed across all pages.
+func findServiceLastAccessedDetailsByJobID(ctx context.Context, conn *iam.Client, jobID string) (*iam.GetServiceLastAccessedDetailsOutput, []awstypes.ServiceLastAccessed, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+	var details *iam.GetServiceLastAccessedDetailsOutput
+	var services []awstypes.ServiceLastAccessed
+
+	for {
+		output, err := conn.GetServiceLastAccessedDetails(ctx, input)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if output == nil {
+			return nil, nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if details == nil {
+			details = output
+		}
+		services = append(services, output.ServicesLastAccessed...)
+
+		if !output.IsTruncated {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	return details, services, nil
+}
+
+func flattenServicesLastAccessed(apiObjects []awstypes.ServiceLastAccessed) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"last_authenticated_entity":     aws.ToString(apiObject.LastAuthenticatedEntity),
+			"last_authenticated_region":     aws.ToString(apiObject.LastAuthenticatedRegion),
+			"service_name":                  aws.ToString(apiObject.ServiceName),
+			"service_namespace":             aws.ToString(apiObject.ServiceNamespace),
+			"total_authenticated_entities":  aws.ToInt32(apiObject.TotalAuthenticatedEntities),
+			"tracked_actions_last_accessed": flattenTrackedActionsLastAccessed(apiObject.TrackedActionsLastAccessed),
+		}
+
+		if v := apiObject.LastAuthenticated; v != nil {
+			tfMap["last_authenticated"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTrackedActionsLastAccessed(apiObjects []awstypes.TrackedActionLastAccessed) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"action_name":          aws.ToString(apiObject.ActionName),
+			"last_accessed_entity": aws.ToString(apiObject.LastAccessedEntity),
+			"last_accessed_region": aws.ToString(apiObject.LastAccessedRegion),
+		}
+
+		if v := apiObject.LastAccessedTime; v != nil {
+			tfMap["last_accessed_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
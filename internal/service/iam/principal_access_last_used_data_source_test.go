@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPrincipalAccessLastUsedDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_access_last_used.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalAccessLastUsedDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, roleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "SERVICE_LEVEL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.service_namespace", "s3"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.total_authenticated_entities", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalAccessLastUsedDataSource_actionLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_access_last_used.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalAccessLastUsedDataSourceConfig_actionLevel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "ACTION_LEVEL"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.service_namespace", "s3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services_last_accessed.0.tracked_actions_last_accessed.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalAccessLastUsedDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPrincipalAccessLastUsedDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalAccessLastUsedDataSourceConfig_base(rName), `
+data "aws_iam_principal_access_last_used" "test" {
+  arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccPrincipalAccessLastUsedDataSourceConfig_actionLevel(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalAccessLastUsedDataSourceConfig_base(rName), `
+data "aws_iam_principal_access_last_used" "test" {
+  arn         = aws_iam_role.test.arn
+  granularity = "ACTION_LEVEL"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
@@ -40,33 +40,7 @@ func dataSourcePrincipalPolicySimulation() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Description:  `ARN of a user to use as the caller of the simulated requests. If not specified, defaults to the principal specified in policy_source_arn, if it is a user ARN.`,
 			},
-			"context": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrKey: {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: `The key name of the context entry, such as "aws:CurrentTime".`,
-						},
-						names.AttrType: {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: `The type that the simulator should use to interpret the strings given in argument "values".`,
-						},
-						names.AttrValues: {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: `One or more values to assign to the context key, given as a string in a syntax appropriate for the selected value type.`,
-						},
-					},
-				},
-				Description: `Each block specifies one item of additional context entry to include in the simulated requests. These are the additional properties used in the 'Condition' element of an IAM policy, and in dynamic value interpolations.`,
-			},
+			"context": policySimulationContextSchema(),
 			"permissions_boundary_policies_json": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -124,82 +98,7 @@ func dataSourcePrincipalPolicySimulation() *schema.Resource {
 				Computed:    true,
 				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
 			},
-			"results": {
-				Type:     schema.TypeSet,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"action_name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: `The name of the action whose simulation this result is describing.`,
-						},
-						"decision": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: `The exact decision keyword returned by the policy simulator: "allowed", "explicitDeny", or "implicitDeny".`,
-						},
-						"allowed": {
-							Type:        schema.TypeBool,
-							Computed:    true,
-							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
-						},
-						"decision_details": {
-							Type:     schema.TypeMap,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: `A mapping of various additional details that are relevant to the decision, exactly as returned by the policy simulator.`,
-						},
-						names.AttrResourceARN: {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: `ARN of the resource that the action was tested against.`,
-						},
-						"matched_statements": {
-							Type:     schema.TypeSet,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"source_policy_id": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: `Identifier of one of the policies used as input to the simulation.`,
-									},
-									"source_policy_type": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: `The type of the policy identified in source_policy_id.`,
-									},
-									// NOTE: start position and end position
-									// omitted right now because they would
-									// ideally be singleton objects with
-									// column/line attributes, but this SDK
-									// can't support that. Maybe we later adopt
-									// the new framework and add support for
-									// those.
-								},
-							},
-							Description: `Detail about which specific policies contributed to this result.`,
-						},
-						"missing_context_keys": {
-							Type:     schema.TypeSet,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: `Set of context entry keys that were needed for one or more of the relevant policies but not included in the request. You must specify suitable values for all context keys used in all of the relevant policies in order to obtain a correct simulation result.`,
-						},
-						// NOTE: organizations decision detail, permissions
-						// boundary decision detail, and resource-specific
-						// results omitted for now because it isn't clear
-						// that they will be useful and they would make the
-						// results of this data source considerably larger
-						// and more complicated.
-					},
-				},
-			},
+			"results": policySimulationResultsSchema(),
 			names.AttrID: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -213,30 +112,15 @@ func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	setAsAWSStringSlice := func(raw interface{}) []string {
-		if raw.(*schema.Set).Len() == 0 {
-			return nil
-		}
-		return flex.ExpandStringValueSet(raw.(*schema.Set))
-	}
-
 	input := &iam.SimulatePrincipalPolicyInput{
-		ActionNames:                        setAsAWSStringSlice(d.Get("action_names")),
-		PermissionsBoundaryPolicyInputList: setAsAWSStringSlice(d.Get("permissions_boundary_policies_json")),
-		PolicyInputList:                    setAsAWSStringSlice(d.Get("additional_policies_json")),
+		ActionNames:                        expandPolicySimulationStringSet(d.Get("action_names")),
+		PermissionsBoundaryPolicyInputList: expandPolicySimulationStringSet(d.Get("permissions_boundary_policies_json")),
+		PolicyInputList:                    expandPolicySimulationStringSet(d.Get("additional_policies_json")),
 		PolicySourceArn:                    aws.String(d.Get("policy_source_arn").(string)),
-		ResourceArns:                       setAsAWSStringSlice(d.Get("resource_arns")),
+		ResourceArns:                       expandPolicySimulationStringSet(d.Get("resource_arns")),
 	}
 
-	for _, entryRaw := range d.Get("context").(*schema.Set).List() {
-		entryRaw := entryRaw.(map[string]interface{})
-		entry := awstypes.ContextEntry{
-			ContextKeyName:   aws.String(entryRaw[names.AttrKey].(string)),
-			ContextKeyType:   awstypes.ContextKeyTypeEnum(entryRaw[names.AttrType].(string)),
-			ContextKeyValues: setAsAWSStringSlice(entryRaw[names.AttrValues]),
-		}
-		input.ContextEntries = append(input.ContextEntries, entry)
-	}
+	input.ContextEntries = expandPolicySimulationContextEntries(d.Get("context").(*schema.Set).List())
 
 	if v := d.Get("caller_arn").(string); v != "" {
 		input.CallerArn = aws.String(v)
@@ -276,6 +160,150 @@ func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.Reso
 		input.Marker = output.Marker
 	}
 
+	rawResults, allAllowed := flattenPolicySimulationResults(results)
+	d.Set("results", rawResults)
+	d.Set("all_allowed", allAllowed)
+
+	d.SetId("-")
+
+	return diags
+}
+
+func policySimulationContextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrKey: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: `The key name of the context entry, such as "aws:CurrentTime".`,
+				},
+				names.AttrType: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: `The type that the simulator should use to interpret the strings given in argument "values".`,
+				},
+				names.AttrValues: {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: `One or more values to assign to the context key, given as a string in a syntax appropriate for the selected value type.`,
+				},
+			},
+		},
+		Description: `Each block specifies one item of additional context entry to include in the simulated requests. These are the additional properties used in the 'Condition' element of an IAM policy, and in dynamic value interpolations.`,
+	}
+}
+
+func policySimulationResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The name of the action whose simulation this result is describing.`,
+				},
+				"decision": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The exact decision keyword returned by the policy simulator: "allowed", "explicitDeny", or "implicitDeny".`,
+				},
+				"allowed": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+				},
+				"decision_details": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: `A mapping of various additional details that are relevant to the decision, exactly as returned by the policy simulator.`,
+				},
+				names.AttrResourceARN: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `ARN of the resource that the action was tested against.`,
+				},
+				"matched_statements": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"source_policy_id": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: `Identifier of one of the policies used as input to the simulation.`,
+							},
+							"source_policy_type": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: `The type of the policy identified in source_policy_id.`,
+							},
+							// NOTE: start position and end position
+							// omitted right now because they would
+							// ideally be singleton objects with
+							// column/line attributes, but this SDK
+							// can't support that. Maybe we later adopt
+							// the new framework and add support for
+							// those.
+						},
+					},
+					Description: `Detail about which specific policies contributed to this result.`,
+				},
+				"missing_context_keys": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: `Set of context entry keys that were needed for one or more of the relevant policies but not included in the request. You must specify suitable values for all context keys used in all of the relevant policies in order to obtain a correct simulation result.`,
+				},
+				// NOTE: organizations decision detail, permissions
+				// boundary decision detail, and resource-specific
+				// results omitted for now because it isn't clear
+				// that they will be useful and they would make the
+				// results of this data source considerably larger
+				// and more complicated.
+			},
+		},
+	}
+}
+
+func expandPolicySimulationStringSet(raw interface{}) []string {
+	if raw.(*schema.Set).Len() == 0 {
+		return nil
+	}
+	return flex.ExpandStringValueSet(raw.(*schema.Set))
+}
+
+func expandPolicySimulationContextEntries(tfList []interface{}) []awstypes.ContextEntry {
+	var apiObjects []awstypes.ContextEntry
+
+	for _, entryRaw := range tfList {
+		entryRaw := entryRaw.(map[string]interface{})
+		entry := awstypes.ContextEntry{
+			ContextKeyName:   aws.String(entryRaw[names.AttrKey].(string)),
+			ContextKeyType:   awstypes.ContextKeyTypeEnum(entryRaw[names.AttrType].(string)),
+			ContextKeyValues: expandPolicySimulationStringSet(entryRaw[names.AttrValues]),
+		}
+		apiObjects = append(apiObjects, entry)
+	}
+
+	return apiObjects
+}
+
+// flattenPolicySimulationResults returns the "results" attribute value along
+// with the top-level "all_allowed" summary.
+func flattenPolicySimulationResults(results []awstypes.EvaluationResult) ([]interface{}, bool) {
 	// While we build the result we'll also tally up the number of allowed
 	// vs. denied decisions to use for our top-level "all_allowed" summary
 	// result.
@@ -326,14 +354,9 @@ func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.Reso
 
 		rawResults[i] = rawResult
 	}
-	d.Set("results", rawResults)
 
 	// "all" are allowed only if there is at least one result and no other
 	// results were denied. We require at least one allowed here just as
 	// a safety-net against a confusing result from a degenerate request.
-	d.Set("all_allowed", allowedCount > 0 && deniedCount == 0)
-
-	d.SetId("-")
-
-	return diags
+	return rawResults, allowedCount > 0 && deniedCount == 0
 }
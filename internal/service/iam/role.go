@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +54,10 @@ func resourceRole() *schema.Resource {
 			StateContext: resourceRoleImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -173,7 +178,10 @@ func resourceRole() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffRolePolicySize,
+		),
 	}
 }
 
@@ -206,14 +214,14 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.PermissionsBoundary = aws.String(v.(string))
 	}
 
-	output, err := retryCreateRole(ctx, conn, input)
+	output, err := retryCreateRole(ctx, conn, input, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta))
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	partition := meta.(*conns.AWSClient).Partition
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(partition, err) {
 		input.Tags = nil
 
-		output, err = retryCreateRole(ctx, conn, input)
+		output, err = retryCreateRole(ctx, conn, input, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta))
 	}
 
 	if err != nil {
@@ -225,7 +233,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("inline_policy"); ok && v.(*schema.Set).Len() > 0 {
 		policies := expandRoleInlinePolicies(roleName, v.(*schema.Set).List())
 		if err := addRoleInlinePolicies(ctx, conn, policies); err != nil {
-			derr := deleteRole(ctx, conn, roleName, true, true, false)
+			derr := deleteRole(ctx, conn, roleName, true, true, false, providerPropagationTimeout(ctx, meta))
 			if derr != nil {
 				return sdkdiag.AppendErrorf(diags, "creating IAM role (%s), inline policy failed (%s), deleting role: %s", d.Id(), err, derr)
 			}
@@ -236,7 +244,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if v, ok := d.GetOk("managed_policy_arns"); ok && v.(*schema.Set).Len() > 0 {
 		managedPolicies := flex.ExpandStringSet(v.(*schema.Set))
-		if err := addRoleManagedPolicies(ctx, conn, roleName, managedPolicies); err != nil {
+		if err := addRoleManagedPolicies(ctx, conn, roleName, managedPolicies, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating IAM Role (%s): %s", name, err)
 		}
 	}
@@ -264,7 +272,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findRoleByName(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
@@ -281,7 +289,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	role := outputRaw.(*awstypes.Role)
 
 	// occasionally, immediately after a role is created, AWS will give an ARN like AROAQ7SSZBKHREXAMPLE (unique ID)
-	if role, err = waitRoleARNIsNotUniqueID(ctx, conn, d.Id(), role); err != nil {
+	if role, err = waitRoleARNIsNotUniqueID(ctx, conn, d.Id(), role, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s): waiting for valid ARN: %s", d.Id(), err)
 	}
 
@@ -353,7 +361,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			PolicyDocument: aws.String(assumeRolePolicy),
 		}
 
-		_, err = tfresource.RetryWhen(ctx, propagationTimeout,
+		_, err = tfresource.RetryWhen(ctx, providerPropagationTimeout(ctx, meta),
 			func() (interface{}, error) {
 				return conn.UpdateAssumeRolePolicy(ctx, input)
 			},
@@ -473,7 +481,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			return sdkdiag.AppendErrorf(diags, "updating IAM Role (%s): %s", d.Id(), err)
 		}
 
-		if err := addRoleManagedPolicies(ctx, conn, d.Id(), add); err != nil {
+		if err := addRoleManagedPolicies(ctx, conn, d.Id(), add, providerPropagationTimeout(ctx, meta)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM Role (%s): %s", d.Id(), err)
 		}
 	}
@@ -495,7 +503,7 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		hasManaged = true
 	}
 
-	err := deleteRole(ctx, conn, d.Id(), d.Get("force_detach_policies").(bool), hasInline, hasManaged)
+	err := deleteRole(ctx, conn, d.Id(), d.Get("force_detach_policies").(bool), hasInline, hasManaged, providerPropagationTimeout(ctx, meta))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting IAM Role (%s): %s", d.Id(), err)
@@ -509,7 +517,7 @@ func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interf
 	return []*schema.ResourceData{d}, nil
 }
 
-func deleteRole(ctx context.Context, conn *iam.Client, roleName string, forceDetach, hasInline, hasManaged bool) error {
+func deleteRole(ctx context.Context, conn *iam.Client, roleName string, forceDetach, hasInline, hasManaged bool, timeout time.Duration) error {
 	if err := deleteRoleInstanceProfiles(ctx, conn, roleName); err != nil {
 		return err
 	}
@@ -542,7 +550,7 @@ func deleteRole(ctx context.Context, conn *iam.Client, roleName string, forceDet
 		RoleName: aws.String(roleName),
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.DeleteConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenIsA[*awstypes.DeleteConflictException](ctx, timeout, func() (interface{}, error) {
 		return conn.DeleteRole(ctx, input)
 	})
 
@@ -587,8 +595,8 @@ func deleteRoleInstanceProfiles(ctx context.Context, conn *iam.Client, roleName
 	return errors.Join(errsList...)
 }
 
-func retryCreateRole(ctx context.Context, conn *iam.Client, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+func retryCreateRole(ctx context.Context, conn *iam.Client, input *iam.CreateRoleInput, timeout time.Duration) (*iam.CreateRoleOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
 		func() (interface{}, error) {
 			return conn.CreateRole(ctx, input)
 		},
@@ -853,11 +861,11 @@ func addRoleInlinePolicies(ctx context.Context, conn *iam.Client, policies []*ia
 	return errors.Join(errs...)
 }
 
-func addRoleManagedPolicies(ctx context.Context, conn *iam.Client, roleName string, policies []*string) error {
+func addRoleManagedPolicies(ctx context.Context, conn *iam.Client, roleName string, policies []*string, timeout time.Duration) error {
 	var errsList []error
 
 	for _, arn := range policies {
-		if err := attachPolicyToRole(ctx, conn, roleName, aws.ToString(arn)); err != nil {
+		if err := attachPolicyToRole(ctx, conn, roleName, aws.ToString(arn), timeout); err != nil {
 			errsList = append(errsList, err)
 		}
 	}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+func newRolePoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePoliciesExclusiveResource{}, nil
+}
+
+type rolePoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*rolePoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policies_exclusive"
+}
+
+func (r *rolePoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := syncRolePolicies(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	output, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := syncRolePolicies(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), request, response)
+}
+
+// syncRolePolicies deletes any inline policies attached to the role that are not in the desired set.
+// Inline policies are created by aws_iam_role_policy, so desired policies missing from the role are left for that resource to create.
+func syncRolePolicies(ctx context.Context, conn *iam.Client, roleName string, want []string) error {
+	have, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	_, remove, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, name := range remove {
+		input := &iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(name),
+			RoleName:   aws.String(roleName),
+		}
+
+		_, err := conn.DeleteRolePolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Role (%s) Policy (%s): %w", roleName, name, err)
+		}
+	}
+
+	return nil
+}
+
+type rolePoliciesExclusiveResourceModel struct {
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	RoleName    types.String `tfsdk:"role_name"`
+}
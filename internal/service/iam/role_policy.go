@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,6 +45,11 @@ func resourceRolePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+			Update: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrName: {
 				Type:          schema.TypeString,
@@ -77,7 +84,36 @@ func resourceRolePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validRolePolicyRole,
 			},
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"wait_for_propagation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidatePolicy,
+			customizeDiffPolicySize("role inline", policySizeQuotaRoleInline),
+		),
 	}
 }
 
@@ -107,7 +143,7 @@ func resourceRolePolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 	if d.IsNewResource() {
 		d.SetId(fmt.Sprintf("%s:%s", roleName, policyName))
 
-		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		_, err := tfresource.RetryWhenNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 			return FindRolePolicyByTwoPartKey(ctx, conn, roleName, policyName)
 		})
 
@@ -116,6 +152,28 @@ func resourceRolePolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("wait_for_propagation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		role, err := findRoleByName(ctx, conn, roleName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s): %s", roleName, err)
+		}
+
+		timeoutKey := schema.TimeoutUpdate
+		if d.IsNewResource() {
+			timeoutKey = schema.TimeoutCreate
+		}
+
+		actionNames := flex.ExpandStringValueSet(tfMap["action_names"].(*schema.Set))
+		resourceARNs := flex.ExpandStringValueSet(tfMap["resource_arns"].(*schema.Set))
+
+		if err := waitPrincipalPolicyActionsAllowed(ctx, conn, aws.ToString(role.Arn), actionNames, resourceARNs, resourcePropagationTimeout(ctx, d, timeoutKey, meta)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IAM Role Policy (%s) propagation: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceRolePolicyRead(ctx, d, meta)...)
 }
 
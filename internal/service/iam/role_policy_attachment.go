@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -36,6 +37,10 @@ func resourceRolePolicyAttachment() *schema.Resource {
 			StateContext: resourceRolePolicyAttachmentImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy_arn": {
 				Type:         schema.TypeString,
@@ -59,7 +64,7 @@ func resourceRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 	role := d.Get(names.AttrRole).(string)
 	policyARN := d.Get("policy_arn").(string)
 
-	if err := attachPolicyToRole(ctx, conn, role, policyARN); err != nil {
+	if err := attachPolicyToRole(ctx, conn, role, policyARN, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -78,7 +83,7 @@ func resourceRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceDat
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", role, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findAttachedRolePolicyByTwoPartKey(ctx, conn, role, policyARN)
 	}, d.IsNewResource())
 
@@ -99,7 +104,7 @@ func resourceRolePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if err := detachPolicyFromRole(ctx, conn, d.Get(names.AttrRole).(string), d.Get("policy_arn").(string)); err != nil {
+	if err := detachPolicyFromRole(ctx, conn, d.Get(names.AttrRole).(string), d.Get("policy_arn").(string), providerPropagationTimeout(ctx, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -122,9 +127,9 @@ func resourceRolePolicyAttachmentImport(ctx context.Context, d *schema.ResourceD
 	return []*schema.ResourceData{d}, nil
 }
 
-func attachPolicyToRole(ctx context.Context, conn *iam.Client, role, policyARN string) error {
+func attachPolicyToRole(ctx context.Context, conn *iam.Client, role, policyARN string, timeout time.Duration) error {
 	var errConcurrentModificationException *awstypes.ConcurrentModificationException
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
 			PolicyArn: aws.String(policyARN),
 			RoleName:  aws.String(role),
@@ -138,9 +143,9 @@ func attachPolicyToRole(ctx context.Context, conn *iam.Client, role, policyARN s
 	return nil
 }
 
-func detachPolicyFromRole(ctx context.Context, conn *iam.Client, role, policyARN string) error {
+func detachPolicyFromRole(ctx context.Context, conn *iam.Client, role, policyARN string, timeout time.Duration) error {
 	var errConcurrentModificationException *awstypes.ConcurrentModificationException
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
 			PolicyArn: aws.String(policyARN),
 			RoleName:  aws.String(role),
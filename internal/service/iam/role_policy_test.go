@@ -220,6 +220,92 @@ func TestAccIAMRolePolicy_unknownsInPolicy(t *testing.T) {
 	})
 }
 
+func TestAccIAMRolePolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rolePolicy string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AccessAnalyzerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRolePolicyConfig_validatePolicy(rName, "iam:PassRole"),
+				ExpectError: regexache.MustCompile(`PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccRolePolicyConfig_validatePolicy(rName, "ec2:DescribeInstances"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyExists(ctx, resourceName, &rolePolicy),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicy_waitForPropagation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rolePolicy string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyConfig_waitForPropagation(rName, "s3:GetObject", "s3:GetObject", "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyExists(ctx, resourceName, &rolePolicy),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_propagation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_propagation.0.action_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "wait_for_propagation.0.action_names.*", "s3:GetObject"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_propagation"},
+			},
+			{
+				Config: testAccRolePolicyConfig_waitForPropagation(rName, "s3:PutObject", "s3:PutObject", "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyExists(ctx, resourceName, &rolePolicy),
+					resource.TestCheckTypeSetElemAttr(resourceName, "wait_for_propagation.0.action_names.*", "s3:PutObject"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicy_waitForPropagationNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRolePolicyConfig_waitForPropagation(rName, "s3:GetObject", "s3:PutObject", "15s"),
+				ExpectError: regexache.MustCompile(`not allowed: s3:PutObject`),
+			},
+		},
+	})
+}
+
 func testAccCheckRolePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -477,6 +563,40 @@ resource "aws_iam_role_policy" "test" {
 `, rName)
 }
 
+func testAccRolePolicyConfig_validatePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name            = %[1]q
+  role            = aws_iam_role.test.name
+  validate_policy = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = %[2]q
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
+
 func testAccRolePolicyConfig_order(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -620,3 +740,45 @@ resource "aws_iam_role_policy" "test" {
 }
 `, rName)
 }
+
+func testAccRolePolicyConfig_waitForPropagation(rName, policyAction, waitAction, timeout string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = %[2]q
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+
+  wait_for_propagation {
+    action_names = [%[3]q]
+  }
+
+  timeouts {
+    create = %[4]q
+    update = %[4]q
+  }
+}
+`, rName, policyAction, waitAction, timeout)
+}
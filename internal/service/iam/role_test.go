@@ -6,6 +6,7 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -831,6 +832,43 @@ func TestAccIAMRole_InlinePolicy_outOfBandRemovalAddedBack(t *testing.T) {
 	})
 }
 
+// TestAccIAMRole_InlinePolicy_outOfBandModificationReverted: if inline_policy arg
+// exists, an inline policy document changed out of band should be reverted
+func TestAccIAMRole_InlinePolicy_outOfBandModificationReverted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_policyInline(rName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					testAccCheckRolePolicyModifyInlinePolicy(ctx, &role, policyName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRoleConfig_policyInline(rName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", acctest.Ct1),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "inline_policy.*", map[string]*regexp.Regexp{
+						names.AttrPolicy: regexache.MustCompile(`ec2:Describe\*`),
+					}),
+				),
+			},
+		},
+	})
+}
+
 // TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemoved: if managed_policy_arns arg
 // exists and is non-empty, policy attached out of band should be removed
 func TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemoved(t *testing.T) {
@@ -1043,6 +1081,42 @@ func TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemovedEmpty(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_InlinePolicy_sizeQuotaExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig_policyInlineSizeQuotaExceeded(rName),
+				ExpectError: regexache.MustCompile(`inline_policy is \d+ characters .* exceeds the IAM role inline policy size quota of 10240 characters`),
+			},
+		},
+	})
+}
+
+func TestAccIAMRole_AssumeRolePolicy_sizeQuotaExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig_assumeRolePolicySizeQuotaExceeded(rName),
+				ExpectError: regexache.MustCompile(`assume_role_policy is \d+ characters .* exceeds the IAM role trust policy size quota of 4096 characters`),
+			},
+		},
+	})
+}
+
 func testAccCheckRoleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -1241,6 +1315,20 @@ func testAccCheckRolePolicyAddInlinePolicy(ctx context.Context, role *awstypes.R
 	}
 }
 
+func testAccCheckRolePolicyModifyInlinePolicy(ctx context.Context, role *awstypes.Role, inlinePolicy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := conn.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Action":"s3:ListAllMyBuckets","Effect":"Allow","Resource":"*"}]}`),
+			PolicyName:     aws.String(inlinePolicy),
+			RoleName:       role.RoleName,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckRolePolicyRemoveInlinePolicy(ctx context.Context, role *awstypes.Role, inlinePolicy string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -2423,3 +2511,72 @@ EOF
 }
 `, roleName, policyName)
 }
+
+func testAccRoleConfig_policyInlineSizeQuotaExceeded(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+    }]
+  })
+
+  dynamic "inline_policy" {
+    for_each = range(3)
+
+    content {
+      name = "%[1]s-${inline_policy.value}"
+
+      policy = jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Action   = "s3:GetObject"
+          Effect   = "Allow"
+          Resource = "*"
+          Condition = {
+            StringEquals = {
+              "aws:PrincipalTag/team" = [for i in range(400) : "team-${i}"]
+            }
+          }
+        }]
+      })
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRoleConfig_assumeRolePolicySizeQuotaExceeded(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = [for i in range(300) : format("%%012d", i)]
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
@@ -6,6 +6,7 @@ package iam
 import (
 	"context"
 	"reflect"
+	"regexp"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -52,12 +53,19 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	input := &iam.ListRolesInput{}
+	input := &iam.ListRolesInput{
+		MaxItems: aws.Int32(1000),
+	}
 
 	if v, ok := d.GetOk("path_prefix"); ok {
 		input.PathPrefix = aws.String(v.(string))
 	}
 
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexache.MustCompile(v.(string))
+	}
+
 	var results []awstypes.Role
 
 	pages := iam.NewListRolesPaginator(conn, input)
@@ -72,7 +80,7 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 				continue
 			}
 
-			if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(aws.ToString(role.RoleName)) {
+			if nameRegex != nil && !nameRegex.MatchString(aws.ToString(role.RoleName)) {
 				continue
 			}
 
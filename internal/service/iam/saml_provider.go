@@ -6,7 +6,9 @@ package iam
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +30,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Maximum length of a SAML metadata document, in characters.
+	samlMetadataDocumentMaxLen = 10000000
+)
+
 // @SDKResource("aws_iam_saml_provider", name="SAML Provider")
 // @Tags(identifierAttribute="id", resourceType="SAMLProvider")
 // @Testing(tagsTest=false)
@@ -54,8 +62,16 @@ func resourceSAMLProvider() *schema.Resource {
 			},
 			"saml_metadata_document": {
 				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1000, 10000000),
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"saml_metadata_document", "saml_metadata_document_url"},
+				ValidateFunc: validation.StringLenBetween(1000, samlMetadataDocumentMaxLen),
+			},
+			"saml_metadata_document_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"saml_metadata_document", "saml_metadata_document_url"},
+				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -65,7 +81,10 @@ func resourceSAMLProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSAMLProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -75,9 +94,15 @@ func resourceSAMLProviderCreate(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
+	document, err := samlMetadataDocument(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IAM SAML Provider (%s): %s", name, err)
+	}
+
 	input := &iam.CreateSAMLProviderInput{
 		Name:                 aws.String(name),
-		SAMLMetadataDocument: aws.String(d.Get("saml_metadata_document").(string)),
+		SAMLMetadataDocument: aws.String(document),
 		Tags:                 getTagsIn(ctx),
 	}
 
@@ -157,12 +182,18 @@ func resourceSAMLProviderUpdate(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		document, err := samlMetadataDocument(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM SAML Provider (%s): %s", d.Id(), err)
+		}
+
 		input := &iam.UpdateSAMLProviderInput{
 			SAMLProviderArn:      aws.String(d.Id()),
-			SAMLMetadataDocument: aws.String(d.Get("saml_metadata_document").(string)),
+			SAMLMetadataDocument: aws.String(document),
 		}
 
-		_, err := conn.UpdateSAMLProvider(ctx, input)
+		_, err = conn.UpdateSAMLProvider(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM SAML Provider (%s): %s", d.Id(), err)
@@ -193,6 +224,76 @@ func resourceSAMLProviderDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// resourceSAMLProviderCustomizeDiff fetches the metadata document from saml_metadata_document_url, if configured,
+// so that a change to the published document (e.g. rotated IdP signing certificates) results in an update.
+func resourceSAMLProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("saml_metadata_document_url") {
+		return d.SetNewComputed("saml_metadata_document")
+	}
+
+	url := d.Get("saml_metadata_document_url").(string)
+
+	if url == "" {
+		return nil
+	}
+
+	document, err := fetchSAMLMetadataDocument(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), url)
+
+	if err != nil {
+		return err
+	}
+
+	if o, _ := d.GetChange("saml_metadata_document"); o.(string) == document {
+		return nil
+	}
+
+	return d.SetNew("saml_metadata_document", document)
+}
+
+// samlMetadataDocument returns the metadata document to send to IAM.
+// The document is fetched at apply time if its URL was not known during plan.
+func samlMetadataDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("saml_metadata_document"); ok {
+		return v.(string), nil
+	}
+
+	return fetchSAMLMetadataDocument(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), d.Get("saml_metadata_document_url").(string))
+}
+
+// fetchSAMLMetadataDocument fetches the metadata document using the provider's HTTP client,
+// so that the provider's proxy, custom CA bundle and insecure settings apply.
+func fetchSAMLMetadataDocument(ctx context.Context, client *http.Client, url string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return "", fmt.Errorf("fetching SAML metadata document (%s): %w", url, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching SAML metadata document (%s): unexpected HTTP status %s", url, response.Status)
+	}
+
+	bytes, err := io.ReadAll(io.LimitReader(response.Body, samlMetadataDocumentMaxLen+1))
+
+	if err != nil {
+		return "", fmt.Errorf("reading SAML metadata document (%s): %w", url, err)
+	}
+
+	if len(bytes) > samlMetadataDocumentMaxLen {
+		return "", fmt.Errorf("reading SAML metadata document (%s): document exceeds the maximum size of %d bytes", url, samlMetadataDocumentMaxLen)
+	}
+
+	return string(bytes), nil
+}
+
 func findSAMLProviderByARN(ctx context.Context, conn *iam.Client, arn string) (*iam.GetSAMLProviderOutput, error) {
 	input := &iam.GetSAMLProviderInput{
 		SAMLProviderArn: aws.String(arn),
@@ -6,10 +6,16 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -129,6 +135,101 @@ func TestAccIAMSAMLProvider_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIAMSAMLProvider_metadataDocumentURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	idpEntityId := fmt.Sprintf("https://%s", acctest.RandomDomainName())
+	idpEntityIdModified := fmt.Sprintf("https://%s", acctest.RandomDomainName())
+	resourceName := "aws_iam_saml_provider.test"
+
+	document := testAccSAMLProviderMetadataDocument(t, "test-fixtures/saml-metadata.xml.tpl", "${entity_id}", idpEntityId)
+	documentModified := testAccSAMLProviderMetadataDocument(t, "test-fixtures/saml-metadata-modified.xml.tpl", "${entity_id_modified}", idpEntityIdModified)
+
+	// The provider runs in-process, so it can fetch from a local server.
+	// The server's certificate is self-signed, so the provider is configured with insecure = true.
+	var served atomic.Value
+	served.Store(document)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, served.Load().(string))
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSAMLProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLProviderConfig_metadataDocumentURL(rName, server.URL+"/metadata.xml"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSAMLProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_metadata_document", document),
+					resource.TestCheckResourceAttr(resourceName, "saml_metadata_document_url", server.URL+"/metadata.xml"),
+				),
+			},
+			{
+				// The same document is served, so there's no diff.
+				Config:   testAccSAMLProviderConfig_metadataDocumentURL(rName, server.URL+"/metadata.xml"),
+				PlanOnly: true,
+			},
+			{
+				// A changed document (e.g. rotated signing certificates) results in an in-place update.
+				PreConfig: func() {
+					served.Store(documentModified)
+				},
+				Config: testAccSAMLProviderConfig_metadataDocumentURL(rName, server.URL+"/metadata.xml"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSAMLProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_metadata_document", documentModified),
+				),
+			},
+		},
+	})
+}
+
+func TestFetchSAMLMetadataDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const document = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com"/>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/metadata.xml":
+			fmt.Fprint(w, document)
+		case "/oversized.xml":
+			fmt.Fprint(w, strings.Repeat("x", 10000001))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	got, err := tfiam.FetchSAMLMetadataDocument(ctx, server.Client(), server.URL+"/metadata.xml")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != document {
+		t.Errorf("got %q, want %q", got, document)
+	}
+
+	if _, err := tfiam.FetchSAMLMetadataDocument(ctx, server.Client(), server.URL+"/missing.xml"); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	if _, err := tfiam.FetchSAMLMetadataDocument(ctx, server.Client(), server.URL+"/oversized.xml"); err == nil {
+		t.Error("expected error for oversized document, got none")
+	}
+}
+
 func testAccCheckSAMLProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -218,3 +319,28 @@ resource "aws_iam_saml_provider" "test" {
 }
 `, rName, idpEntityId, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccSAMLProviderMetadataDocument(t *testing.T, path, placeholder, entityID string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strings.ReplaceAll(string(b), placeholder, entityID)
+}
+
+func testAccSAMLProviderConfig_metadataDocumentURL(rName, url string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  insecure = true
+}
+
+resource "aws_iam_saml_provider" "test" {
+  name                       = %[1]q
+  saml_metadata_document_url = %[2]q
+}
+`, rName, url)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKResource("aws_iam_security_token_service_preferences", name="Security Token Service Preferences")
@@ -24,6 +25,10 @@ func resourceSecurityTokenServicePreferences() *schema.Resource {
 		UpdateWithoutTimeout: resourceSecurityTokenServicePreferencesUpsert,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"global_endpoint_token_version": {
 				Type:             schema.TypeString,
@@ -59,13 +64,29 @@ func resourceSecurityTokenServicePreferencesRead(ctx context.Context, d *schema.
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	output, err := conn.GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+	globalEndpointTokenVersion, err := findGlobalEndpointTokenVersion(ctx, conn)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading IAM Account Summary: %s", err)
+		return sdkdiag.AppendErrorf(diags, "reading IAM Security Token Service Preferences: %s", err)
 	}
 
-	d.Set("global_endpoint_token_version", fmt.Sprintf("v%dToken", output.SummaryMap[string(awstypes.SummaryKeyTypeGlobalEndpointTokenVersion)]))
+	d.Set("global_endpoint_token_version", globalEndpointTokenVersion)
 
 	return diags
 }
+
+func findGlobalEndpointTokenVersion(ctx context.Context, conn *iam.Client) (awstypes.GlobalEndpointTokenVersion, error) {
+	input := &iam.GetAccountSummaryInput{}
+
+	output, err := conn.GetAccountSummary(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.SummaryMap == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return awstypes.GlobalEndpointTokenVersion(fmt.Sprintf("v%dToken", output.SummaryMap[string(awstypes.SummaryKeyTypeGlobalEndpointTokenVersion)])), nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_iam_security_token_service_preferences", name="Security Token Service Preferences")
+func dataSourceSecurityTokenServicePreferences() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecurityTokenServicePreferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"global_endpoint_token_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityTokenServicePreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	globalEndpointTokenVersion, err := findGlobalEndpointTokenVersion(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Security Token Service Preferences: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("global_endpoint_token_version", globalEndpointTokenVersion)
+
+	return diags
+}
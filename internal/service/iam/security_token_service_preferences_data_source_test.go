@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMSecurityTokenServicePreferencesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_security_token_service_preferences.test"
+	resourceName := "aws_iam_security_token_service_preferences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityTokenServicePreferencesDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_endpoint_token_version", resourceName, "global_endpoint_token_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSecurityTokenServicePreferencesDataSourceConfig_basic = `
+resource "aws_iam_security_token_service_preferences" "test" {
+  global_endpoint_token_version = "v2Token"
+}
+
+data "aws_iam_security_token_service_preferences" "test" {
+  depends_on = [aws_iam_security_token_service_preferences.test]
+}
+`
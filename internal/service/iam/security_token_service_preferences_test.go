@@ -27,6 +27,11 @@ func TestAccIAMSecurityTokenServicePreferences_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "global_endpoint_token_version", "v2Token"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,7 +19,24 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newGroupPoliciesExclusiveResource,
+			Name:    "Group Policies Exclusive",
+		},
+		{
+			Factory: newOrganizationsFeaturesResource,
+			Name:    "Organizations Features",
+		},
+		{
+			Factory: newRolePoliciesExclusiveResource,
+			Name:    "Role Policies Exclusive",
+		},
+		{
+			Factory: newUserPoliciesExclusiveResource,
+			Name:    "User Policies Exclusive",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -34,6 +51,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_account_alias",
 			Name:     "Account Alias",
 		},
+		{
+			Factory:  dataSourceAccountPasswordPolicy,
+			TypeName: "aws_iam_account_password_policy",
+			Name:     "Account Password Policy",
+		},
+		{
+			Factory:  dataSourceCredentialReport,
+			TypeName: "aws_iam_credential_report",
+			Name:     "Credential Report",
+		},
 		{
 			Factory:  dataSourceGroup,
 			TypeName: "aws_iam_group",
@@ -66,6 +93,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
 		},
+		{
+			Factory:  dataSourcePolicySimulation,
+			TypeName: "aws_iam_policy_simulation",
+			Name:     "Policy Simulation",
+		},
+		{
+			Factory:  dataSourcePrincipalAccessLastUsed,
+			TypeName: "aws_iam_principal_access_last_used",
+			Name:     "Principal Access Last Used",
+		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -87,6 +124,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_saml_provider",
 			Name:     "SAML Provider",
 		},
+		{
+			Factory:  dataSourceSecurityTokenServicePreferences,
+			TypeName: "aws_iam_security_token_service_preferences",
+			Name:     "Security Token Service Preferences",
+		},
 		{
 			Factory:  dataSourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
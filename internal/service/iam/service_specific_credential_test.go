@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,6 +139,96 @@ func TestAccIAMServiceSpecificCredential_status(t *testing.T) {
 	})
 }
 
+func TestAccIAMServiceSpecificCredential_keyspaces(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cred awstypes.ServiceSpecificCredentialMetadata
+
+	resourceName := "aws_iam_service_specific_credential.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceSpecificCredentialDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSpecificCredentialConfig_serviceName(rName, "cassandra.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(ctx, resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, names.AttrServiceName, "cassandra.amazonaws.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_password"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_user_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password"},
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceSpecificCredential_bedrockUnsupported(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceSpecificCredentialDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceSpecificCredentialConfig_serviceName(rName, "bedrock.amazonaws.com"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`expected service_name to not be any of \[bedrock.amazonaws.com\]`),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceSpecificCredential_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cred1, cred2 awstypes.ServiceSpecificCredentialMetadata
+
+	resourceName := "aws_iam_service_specific_credential.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceSpecificCredentialDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSpecificCredentialConfig_triggers(rName, "2024-Q1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(ctx, resourceName, &cred1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2024-Q1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password", names.AttrTriggers},
+			},
+			{
+				Config: testAccServiceSpecificCredentialConfig_triggers(rName, "2024-Q2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(ctx, resourceName, &cred2),
+					testAccCheckServiceSpecificCredentialRotated(&cred1, &cred2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2024-Q2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMServiceSpecificCredential_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cred awstypes.ServiceSpecificCredentialMetadata
@@ -191,6 +283,16 @@ func testAccCheckServiceSpecificCredentialExists(ctx context.Context, n string,
 	}
 }
 
+func testAccCheckServiceSpecificCredentialRotated(before, after *awstypes.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if id := aws.ToString(before.ServiceSpecificCredentialId); id == aws.ToString(after.ServiceSpecificCredentialId) {
+			return fmt.Errorf("IAM Service Specific Credential (%s) not rotated", id)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckServiceSpecificCredentialDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -264,3 +366,37 @@ resource "aws_iam_service_specific_credential" "test" {
 }
 `, rName, status)
 }
+
+func testAccServiceSpecificCredentialConfig_serviceName(rName, serviceName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = %[2]q
+  user_name    = aws_iam_user.test.name
+}
+`, rName, serviceName)
+}
+
+func testAccServiceSpecificCredentialConfig_triggers(rName, rotation string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = "codecommit.amazonaws.com"
+  user_name    = aws_iam_user.test.name
+
+  triggers = {
+    rotation = %[2]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, rotation)
+}
@@ -74,7 +74,7 @@ func dataSourceSessionContextRead(ctx context.Context, d *schema.ResourceData, m
 
 	var role *awstypes.Role
 
-	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, providerPropagationTimeout(ctx, meta), func() *retry.RetryError {
 		var err error
 
 		role, err = findRoleByName(ctx, conn, roleName)
@@ -463,7 +463,7 @@ func sweepRoles(region string) error {
 	for _, roleName := range roles {
 		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
 
-		err := deleteRole(ctx, conn, roleName, true, true, true)
+		err := deleteRole(ctx, conn, roleName, true, true, true, propagationTimeout)
 
 		if tfawserr.ErrCodeContains(err, "AccessDenied") {
 			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
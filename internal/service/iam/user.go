@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,6 +42,10 @@ func resourceUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -143,7 +148,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findUserByName(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
@@ -235,17 +240,22 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// All access keys, MFA devices and login profile for the user must be removed.
 	if d.Get(names.AttrForceDestroy).(bool) {
+		timeout := providerPropagationTimeout(ctx, meta)
 		for _, v := range []struct {
 			f      func(context.Context, *iam.Client, string) error
 			format string
 		}{
 			{deleteUserPolicies, "removing IAM User (%s) policies: %s"},
-			{detachUserPolicies, "detaching IAM User (%s) policies: %s"},
+			{func(ctx context.Context, conn *iam.Client, username string) error {
+				return detachUserPolicies(ctx, conn, username, timeout)
+			}, "detaching IAM User (%s) policies: %s"},
 			{deleteUserAccessKeys, "removing IAM User (%s) access keys: %s"},
 			{deleteUserSSHKeys, "removing IAM User (%s) access keys: %s"},
 			{deleteUserVirtualMFADevices, "removing IAM User (%s) Virtual MFA devices: %s"},
 			{deactivateUserMFADevices, "removing IAM User (%s) MFA devices: %s"},
-			{deleteUserLoginProfile, "removing IAM User (%s) login profile: %s"},
+			{func(ctx context.Context, conn *iam.Client, username string) error {
+				return deleteUserLoginProfile(ctx, conn, username, timeout)
+			}, "removing IAM User (%s) login profile: %s"},
 			{deleteUserSigningCertificates, "removing IAM User (%s) signing certificate: %s"},
 			{deleteServiceSpecificCredentials, "removing IAM User (%s) Service Specific Credentials: %s"},
 		} {
@@ -431,12 +441,12 @@ func deactivateUserMFADevices(ctx context.Context, conn *iam.Client, username st
 	return nil
 }
 
-func deleteUserLoginProfile(ctx context.Context, conn *iam.Client, username string) error {
+func deleteUserLoginProfile(ctx context.Context, conn *iam.Client, username string, timeout time.Duration) error {
 	var err error
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(username),
 	}
-	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		_, err = conn.DeleteLoginProfile(ctx, input)
 		if err != nil {
 			var errNoSuchEntityException *awstypes.NoSuchEntityException
@@ -572,7 +582,7 @@ func deleteUserPolicies(ctx context.Context, conn *iam.Client, username string)
 	return nil
 }
 
-func detachUserPolicies(ctx context.Context, conn *iam.Client, username string) error {
+func detachUserPolicies(ctx context.Context, conn *iam.Client, username string, timeout time.Duration) error {
 	input := &iam.ListAttachedUserPoliciesInput{
 		UserName: aws.String(username),
 	}
@@ -592,7 +602,7 @@ func detachUserPolicies(ctx context.Context, conn *iam.Client, username string)
 
 		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
 
-		if err := detachPolicyFromUser(ctx, conn, username, policyARN); err != nil {
+		if err := detachPolicyFromUser(ctx, conn, username, policyARN, timeout); err != nil {
 			return fmt.Errorf("detaching IAM User (%s) attached policy: %s", username, err)
 		}
 	}
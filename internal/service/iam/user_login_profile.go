@@ -190,7 +190,7 @@ func resourceUserLoginProfileRead(ctx context.Context, d *schema.ResourceData, m
 
 	var output *iam.GetLoginProfileOutput
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, providerPropagationTimeout(ctx, meta), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.GetLoginProfile(ctx, input)
@@ -238,7 +238,7 @@ func resourceUserLoginProfileDelete(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] Deleting IAM User Login Profile (%s): %v", d.Id(), input)
 	// Handle IAM eventual consistency
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, providerPropagationTimeout(ctx, meta), func() *retry.RetryError {
 		_, err := conn.DeleteLoginProfile(ctx, input)
 
 		var nse *awstypes.NoSuchEntityException
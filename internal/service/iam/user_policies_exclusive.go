@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
+func newUserPoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &userPoliciesExclusiveResource{}, nil
+}
+
+type userPoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*userPoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_user_policies_exclusive"
+}
+
+func (r *userPoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userPoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	if err := syncUserPolicies(ctx, conn, userName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userPoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	output, err := findUserPolicyNames(ctx, conn, userName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userPoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	if err := syncUserPolicies(ctx, conn, userName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userPoliciesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrUserName), request, response)
+}
+
+// syncUserPolicies deletes any inline policies attached to the user that are not in the desired set.
+// Inline policies are created by aws_iam_user_policy, so desired policies missing from the user are left for that resource to create.
+func syncUserPolicies(ctx context.Context, conn *iam.Client, userName string, want []string) error {
+	have, err := findUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return err
+	}
+
+	_, remove, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, name := range remove {
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(name),
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM User (%s) Policy (%s): %w", userName, name, err)
+		}
+	}
+
+	return nil
+}
+
+func findUserPolicyNames(ctx context.Context, conn *iam.Client, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	pages := iam.NewListUserPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != "" {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type userPoliciesExclusiveResourceModel struct {
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	UserName    types.String `tfsdk:"user_name"`
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -37,6 +38,10 @@ func resourceUserPolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrName: {
 				Type:          schema.TypeString,
@@ -68,7 +73,16 @@ func resourceUserPolicy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidatePolicy,
+			customizeDiffPolicySize("user inline", policySizeQuotaUserInline),
+		),
 	}
 }
 
@@ -98,7 +112,7 @@ func resourceUserPolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 	if d.IsNewResource() {
 		d.SetId(fmt.Sprintf("%s:%s", userName, policyName))
 
-		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		_, err := tfresource.RetryWhenNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 			return FindUserPolicyByTwoPartKey(ctx, conn, userName, policyName)
 		})
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -35,6 +36,10 @@ func resourceUserPolicyAttachment() *schema.Resource {
 			StateContext: resourceUserPolicyAttachmentImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy_arn": {
 				Type:         schema.TypeString,
@@ -58,7 +63,7 @@ func resourceUserPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 	user := d.Get("user").(string)
 	policyARN := d.Get("policy_arn").(string)
 
-	if err := attachPolicyToUser(ctx, conn, user, policyARN); err != nil {
+	if err := attachPolicyToUser(ctx, conn, user, policyARN, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -77,7 +82,7 @@ func resourceUserPolicyAttachmentRead(ctx context.Context, d *schema.ResourceDat
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", user, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourcePropagationTimeout(ctx, d, schema.TimeoutCreate, meta), func() (interface{}, error) {
 		return findAttachedUserPolicyByTwoPartKey(ctx, conn, user, policyARN)
 	}, d.IsNewResource())
 
@@ -98,7 +103,7 @@ func resourceUserPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if err := detachPolicyFromUser(ctx, conn, d.Get("user").(string), d.Get("policy_arn").(string)); err != nil {
+	if err := detachPolicyFromUser(ctx, conn, d.Get("user").(string), d.Get("policy_arn").(string), providerPropagationTimeout(ctx, meta)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -121,8 +126,8 @@ func resourceUserPolicyAttachmentImport(ctx context.Context, d *schema.ResourceD
 	return []*schema.ResourceData{d}, nil
 }
 
-func attachPolicyToUser(ctx context.Context, conn *iam.Client, user, policyARN string) error {
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (interface{}, error) {
+func attachPolicyToUser(ctx context.Context, conn *iam.Client, user, policyARN string, timeout time.Duration) error {
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, timeout, func() (interface{}, error) {
 		return conn.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
 			PolicyArn: aws.String(policyARN),
 			UserName:  aws.String(user),
@@ -136,8 +141,8 @@ func attachPolicyToUser(ctx context.Context, conn *iam.Client, user, policyARN s
 	return nil
 }
 
-func detachPolicyFromUser(ctx context.Context, conn *iam.Client, user, policyARN string) error {
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (interface{}, error) {
+func detachPolicyFromUser(ctx context.Context, conn *iam.Client, user, policyARN string, timeout time.Duration) error {
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, timeout, func() (interface{}, error) {
 		return conn.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{
 			PolicyArn: aws.String(policyARN),
 			UserName:  aws.String(user),
@@ -227,6 +227,54 @@ func TestAccIAMUserPolicy_policyOrder(t *testing.T) {
 	})
 }
 
+func TestAccIAMUserPolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var userPolicy string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_user_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AccessAnalyzerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserPolicyConfig_validatePolicy(rName, "iam:PassRole"),
+				ExpectError: regexache.MustCompile(`PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccUserPolicyConfig_validatePolicy(rName, "ec2:DescribeInstances"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserPolicyExists(ctx, resourceName, &userPolicy),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMUserPolicy_sizeQuotaExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserPolicyConfig_sizeQuotaExceeded(rName),
+				ExpectError: regexache.MustCompile(`exceeds the IAM user inline policy size quota of 2048 characters`),
+			},
+		},
+	})
+}
+
 func testAccCheckUserPolicyExists(ctx context.Context, n string, v *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -371,6 +419,48 @@ resource "aws_iam_user_policy" "test2" {
 `, rName, policy1, policy2))
 }
 
+func testAccUserPolicyConfig_validatePolicy(rName, action string) string {
+	return acctest.ConfigCompose(testAccUserPolicyUserConfig_base(rName, "/"), fmt.Sprintf(`
+resource "aws_iam_user_policy" "test" {
+  name            = %[1]q
+  user            = aws_iam_user.test.name
+  validate_policy = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = %[2]q
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action))
+}
+
+func testAccUserPolicyConfig_sizeQuotaExceeded(rName string) string {
+	return acctest.ConfigCompose(testAccUserPolicyUserConfig_base(rName, "/"), fmt.Sprintf(`
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "*"
+      Condition = {
+        StringEquals = {
+          "aws:PrincipalTag/team" = [for i in range(200) : "team-${i}"]
+        }
+      }
+    }]
+  })
+}
+`, rName))
+}
+
 func testAccUserPolicyConfig_order(rName string) string {
 	return acctest.ConfigCompose(testAccUserPolicyUserConfig_base(rName, "/"), fmt.Sprintf(`
 resource "aws_iam_user_policy" "test" {
@@ -5,13 +5,18 @@ package iam
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -22,6 +27,7 @@ const (
 	// as this will negatively impact user experience when configurations
 	// have incorrect references or permissions.
 	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/troubleshoot_general.html#troubleshoot_general_eventual-consistency
+	// Can be overridden with the provider-level `iam_propagation_timeout` argument.
 	propagationTimeout = 2 * time.Minute
 
 	RoleStatusARNIsUniqueID = "uniqueid"
@@ -29,7 +35,43 @@ const (
 	RoleStatusNotFound      = "notfound"
 )
 
-func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string, role *awstypes.Role) (*awstypes.Role, error) {
+// providerPropagationTimeout returns the maximum amount of time to wait for IAM changes to propagate,
+// honoring the provider-level `iam_propagation_timeout` argument.
+func providerPropagationTimeout(ctx context.Context, meta interface{}) time.Duration {
+	if v := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx); v > 0 {
+		return v
+	}
+
+	return propagationTimeout
+}
+
+// resourcePropagationTimeout returns the maximum amount of time to wait for IAM changes to propagate
+// during the specified resource operation.
+// A value configured in the resource's `timeouts` block takes precedence over the provider-level value.
+func resourcePropagationTimeout(ctx context.Context, d *schema.ResourceData, key string, meta interface{}) time.Duration {
+	if timeoutConfigured(d.GetRawConfig(), key) {
+		return d.Timeout(key)
+	}
+
+	return providerPropagationTimeout(ctx, meta)
+}
+
+// timeoutConfigured returns whether the specified operation timeout is set in the resource's `timeouts` block.
+// The configured value can't be compared against the default as a user may explicitly configure the default value.
+func timeoutConfigured(rawConfig cty.Value, key string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return false
+	}
+
+	timeouts := rawConfig.GetAttr(schema.TimeoutsConfigKey)
+	if timeouts.IsNull() || !timeouts.IsKnown() || !timeouts.Type().IsObjectType() || !timeouts.Type().HasAttribute(key) {
+		return false
+	}
+
+	return !timeouts.GetAttr(key).IsNull()
+}
+
+func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string, role *awstypes.Role, timeout time.Duration) (*awstypes.Role, error) {
 	if arn.IsARN(aws.ToString(role.Arn)) {
 		return role, nil
 	}
@@ -38,7 +80,7 @@ func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string,
 		Pending:                   []string{RoleStatusARNIsUniqueID, RoleStatusNotFound},
 		Target:                    []string{names.AttrARN},
 		Refresh:                   statusRoleCreate(ctx, conn, id),
-		Timeout:                   propagationTimeout,
+		Timeout:                   timeout,
 		NotFoundChecks:            10,
 		ContinuousTargetOccurence: 5,
 	}
@@ -71,3 +113,45 @@ func statusRoleCreate(ctx context.Context, conn *iam.Client, id string) retry.St
 		return role, RoleStatusARNIsUniqueID, nil
 	}
 }
+
+// waitPrincipalPolicyActionsAllowed waits until the policy simulator evaluates every action as allowed for the principal.
+// The simulator reads the same eventually consistent policy store as request authorization does.
+func waitPrincipalPolicyActionsAllowed(ctx context.Context, conn *iam.Client, principalARN string, actionNames, resourceARNs []string, timeout time.Duration) error {
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actionNames,
+		PolicySourceArn: aws.String(principalARN),
+	}
+
+	if len(resourceARNs) > 0 {
+		input.ResourceArns = resourceARNs
+	}
+
+	var notAllowed []string
+
+	_, err := tfresource.RetryUntilEqual(ctx, timeout, true, func() (bool, error) {
+		notAllowed = nil
+
+		pages := iam.NewSimulatePrincipalPolicyPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return false, err
+			}
+
+			for _, v := range page.EvaluationResults {
+				if v.EvalDecision != awstypes.PolicyEvaluationDecisionTypeAllowed {
+					notAllowed = append(notAllowed, fmt.Sprintf("%s on %s (%s)", aws.ToString(v.EvalActionName), aws.ToString(v.EvalResourceName), v.EvalDecision))
+				}
+			}
+		}
+
+		return len(notAllowed) == 0, nil
+	})
+
+	if err != nil && len(notAllowed) > 0 {
+		return fmt.Errorf("%w: not allowed: %s", err, strings.Join(notAllowed, ", "))
+	}
+
+	return err
+}
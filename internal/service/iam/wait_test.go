@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTimeoutConfigured(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := cty.Object(map[string]cty.Type{
+		schema.TimeoutCreate: cty.String,
+	})
+	configType := cty.Object(map[string]cty.Type{
+		"name":                   cty.String,
+		schema.TimeoutsConfigKey: timeoutsType,
+	})
+
+	testCases := map[string]struct {
+		rawConfig cty.Value
+		key       string
+		expected  bool
+	}{
+		"null config": {
+			rawConfig: cty.NullVal(configType),
+			key:       schema.TimeoutCreate,
+			expected:  false,
+		},
+		"no timeouts attribute": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("test"),
+			}),
+			key:      schema.TimeoutCreate,
+			expected: false,
+		},
+		"null timeouts block": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":                   cty.StringVal("test"),
+				schema.TimeoutsConfigKey: cty.NullVal(timeoutsType),
+			}),
+			key:      schema.TimeoutCreate,
+			expected: false,
+		},
+		"create not set": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("test"),
+				schema.TimeoutsConfigKey: cty.ObjectVal(map[string]cty.Value{
+					schema.TimeoutCreate: cty.NullVal(cty.String),
+				}),
+			}),
+			key:      schema.TimeoutCreate,
+			expected: false,
+		},
+		"create set to default": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("test"),
+				schema.TimeoutsConfigKey: cty.ObjectVal(map[string]cty.Value{
+					schema.TimeoutCreate: cty.StringVal("2m"),
+				}),
+			}),
+			key:      schema.TimeoutCreate,
+			expected: true,
+		},
+		"other key": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("test"),
+				schema.TimeoutsConfigKey: cty.ObjectVal(map[string]cty.Value{
+					schema.TimeoutCreate: cty.StringVal("5m"),
+				}),
+			}),
+			key:      schema.TimeoutDelete,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := timeoutConfigured(testCase.rawConfig, testCase.key), testCase.expected; got != want {
+				t.Errorf("timeoutConfigured() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -29,7 +30,7 @@ func ResourceComponent() *schema.Resource {
 		CreateWithoutTimeout: resourceComponentCreate,
 		ReadWithoutTimeout:   resourceComponentRead,
 		UpdateWithoutTimeout: resourceComponentUpdate,
-		DeleteWithoutTimeout: resourceComponentDelete,
+		DeleteWithoutTimeout: sdkv2.SkipDestroyDeleteFunc("Image Builder Component version", resourceComponentDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -89,11 +90,7 @@ func ResourceComponent() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(imagebuilder.Platform_Values(), false),
 			},
-			names.AttrSkipDestroy: {
-				Type:     schema.TypeBool,
-				Default:  false,
-				Optional: true,
-			},
+			names.AttrSkipDestroy: sdkv2.SkipDestroySchema(),
 			"supported_os_versions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -246,11 +243,6 @@ func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
-		log.Printf("[DEBUG] Retaining Imagebuilder Component version %q", d.Id())
-		return diags
-	}
-
 	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
 
 	input := &imagebuilder.DeleteComponentInput{
@@ -368,6 +368,15 @@ func resourceFunction() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"published_version_optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wait_for_optimization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -459,6 +468,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkLoggingConfigLogLevels,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -582,7 +592,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	_, err := retryFunctionOp(ctx, func() (*lambda.CreateFunctionOutput, error) {
+	output, err := retryFunctionOp(ctx, func() (*lambda.CreateFunctionOutput, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -604,6 +614,12 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "awiting for Lambda Function (%s) create: %s", d.Id(), err)
 	}
 
+	if d.Get("publish").(bool) && d.Get("snap_start.0.wait_for_optimization").(bool) {
+		if _, err := waitFunctionVersionOptimized(ctx, conn, d.Id(), aws.ToString(output.Version), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) version (%s) SnapStart optimization: %s", d.Id(), aws.ToString(output.Version), err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -699,9 +715,6 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
 	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
-	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
-	}
 	d.Set("source_code_hash", d.Get("source_code_hash"))
 	d.Set("source_code_size", function.CodeSize)
 	d.Set(names.AttrTimeout, function.Timeout)
@@ -720,6 +733,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
+	publishedSnapStart := function.SnapStart
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
 		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
@@ -735,10 +749,24 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("qualified_arn", qualifiedARN)
 		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), qualifiedARN))
 		d.Set(names.AttrVersion, latest.Version)
+		publishedSnapStart = latest.SnapStart
 
 		setTagsOut(ctx, output.Tags)
 	}
 
+	snapStart := flattenSnapStart(function.SnapStart)
+	if len(snapStart) > 0 {
+		tfMap := snapStart[0].(map[string]interface{})
+		if publishedSnapStart != nil {
+			tfMap["published_version_optimization_status"] = publishedSnapStart.OptimizationStatus
+		}
+		// wait_for_optimization is not returned by the API and is carried over from configuration.
+		tfMap["wait_for_optimization"] = d.Get("snap_start.0.wait_for_optimization").(bool)
+	}
+	if err := d.Set("snap_start", snapStart); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -887,7 +915,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Runtime = awstypes.Runtime(d.Get("runtime").(string))
 		}
 
-		if d.HasChange("snap_start") {
+		if d.HasChange("snap_start.0.apply_on") {
 			input.SnapStart = expandSnapStart(d.Get("snap_start").([]interface{}))
 		}
 
@@ -1035,6 +1063,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
 		}
+
+		if d.Get("snap_start.0.wait_for_optimization").(bool) {
+			if _, err := waitFunctionVersionOptimized(ctx, conn, d.Id(), aws.ToString(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) version (%s) SnapStart optimization: %s", d.Id(), aws.ToString(output.Version), err)
+			}
+		}
 	}
 
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
@@ -1223,6 +1257,25 @@ func statusFunctionState(ctx context.Context, conn *lambda.Client, name string)
 	}
 }
 
+func statusFunctionVersionState(ctx context.Context, conn *lambda.Client, name, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(version),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Configuration, string(output.Configuration.State), nil
+	}
+}
+
 func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string, timeout time.Duration) (*awstypes.FunctionConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatePending),
@@ -1263,6 +1316,32 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	return nil, err
 }
 
+// waitFunctionVersionOptimized waits for a published version to become active.
+// Versions of SnapStart-enabled functions remain pending while the snapshot is created.
+func waitFunctionVersionOptimized(ctx context.Context, conn *lambda.Client, name, version string, timeout time.Duration) (*awstypes.FunctionConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatePending),
+		Target:  enum.Slice(awstypes.StateActive),
+		Refresh: statusFunctionVersionState(ctx, conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FunctionConfiguration); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
+
+		if err == nil && output.SnapStart != nil && output.SnapStart.OptimizationStatus != awstypes.SnapStartOptimizationStatusOn {
+			err = fmt.Errorf("unexpected SnapStart optimization status: %s", string(output.SnapStart.OptimizationStatus))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
 type functionCU interface {
@@ -1334,6 +1413,20 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+func checkLoggingConfigLogLevels(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("logging_config.0.log_format").(string) != string(awstypes.LogFormatText) {
+		return nil
+	}
+
+	for _, k := range []string{"application_log_level", "system_log_level"} {
+		if v := d.Get("logging_config.0." + k).(string); v != "" {
+			return fmt.Errorf("logging_config.0.%s cannot be set when log_format is %s", k, awstypes.LogFormatText)
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1373,7 +1466,7 @@ func needsFunctionConfigUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange(names.AttrKMSKeyARN) ||
 		d.HasChange("layers") ||
 		d.HasChange("dead_letter_config") ||
-		d.HasChange("snap_start") ||
+		d.HasChange("snap_start.0.apply_on") ||
 		d.HasChange("tracing_config") ||
 		d.HasChange("vpc_config.0.ipv6_allowed_for_dual_stack") ||
 		d.HasChange("vpc_config.0.security_group_ids") ||
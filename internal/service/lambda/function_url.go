@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -62,27 +63,43 @@ func resourceFunctionURL() *schema.Resource {
 						"allow_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
 						},
 						"allow_methods": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 6,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(functionURLCORSMethods, false),
+							},
 						},
 						"allow_origins": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 253),
+							},
 						},
 						"expose_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
 						},
 						"max_age": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ValidateFunc: validation.IntAtMost(86400),
+							ValidateFunc: validation.IntBetween(0, 86400),
 						},
 					},
 				},
@@ -117,7 +134,35 @@ func resourceFunctionURL() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: checkFunctionURLCORSWildcards,
+	}
+}
+
+var functionURLCORSMethods = []string{
+	"*",
+	http.MethodDelete,
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPatch,
+	http.MethodPost,
+	http.MethodPut,
+}
+
+// checkFunctionURLCORSWildcards rejects CORS configurations that combine a "*" wildcard with explicit values.
+func checkFunctionURLCORSWildcards(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"allow_headers", "allow_methods", "allow_origins", "expose_headers"} {
+		v, ok := d.GetOk("cors.0." + k)
+		if !ok {
+			continue
+		}
+
+		if set := v.(*schema.Set); set.Len() > 1 && set.Contains("*") {
+			return fmt.Errorf(`cors.0.%s: "*" cannot be combined with other values`, k)
+		}
 	}
+
+	return nil
 }
 
 func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
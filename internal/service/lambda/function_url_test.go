@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -277,6 +278,71 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionURL_corsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionURLConfig_corsMethods(funcName, policyName, roleName, `"GET", "OPTIONS"`),
+				ExpectError: regexache.MustCompile(`expected cors.0.allow_methods.\d+ to be one of`),
+			},
+			{
+				Config:      testAccFunctionURLConfig_corsMethods(funcName, policyName, roleName, `"*", "GET"`),
+				ExpectError: regexache.MustCompile(`cors.0.allow_methods: "\*" cannot be combined with other values`),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_aliasInvokeMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_aliasInvokeMode(funcName, policyName, roleName, "RESPONSE_STREAM"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "RESPONSE_STREAM"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", "live"),
+					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLConfig_aliasInvokeMode(funcName, policyName, roleName, "BUFFERED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -506,6 +572,54 @@ resource "aws_lambda_function_url" "test" {
 `, funcName, aliasName))
 }
 
+func testAccFunctionURLConfig_corsMethods(funcName, policyName, roleName, methods string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_origins = ["https://www.example.com"]
+    allow_methods = [%[2]s]
+  }
+}
+`, funcName, methods))
+}
+
+func testAccFunctionURLConfig_aliasInvokeMode(funcName, policyName, roleName, invokeMode string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  publish       = true
+}
+
+resource "aws_lambda_alias" "live" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  qualifier          = aws_lambda_alias.live.name
+  authorization_type = "AWS_IAM"
+  invoke_mode        = %[2]q
+}
+`, funcName, invokeMode))
+}
+
 func testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, invokeMode string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
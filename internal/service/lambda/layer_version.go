@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,6 +35,7 @@ func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
 		ReadWithoutTimeout:   resourceLayerVersionRead,
+		UpdateWithoutTimeout: resourceLayerVersionUpdate,
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -100,6 +102,11 @@ func resourceLayerVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrS3Bucket: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -213,6 +220,12 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(aws.ToString(output.LayerVersionArn))
 
+	if v, ok := d.GetOk("retain_versions"); ok {
+		if err := pruneLayerVersions(ctx, conn, layerName, output.Version, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning Lambda Layer (%s) Versions: %s", layerName, err)
+		}
+	}
+
 	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
 }
 
@@ -255,6 +268,11 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceLayerVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only non-API attributes can be updated in-place.
+	return resourceLayerVersionRead(ctx, d, meta)
+}
+
 func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -282,6 +300,49 @@ func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// pruneLayerVersions deletes all but the most recent retain versions of a layer.
+// The version that was just published is always kept.
+func pruneLayerVersions(ctx context.Context, conn *lambda.Client, layerName string, publishedVersion int64, retain int) error {
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+	var versions []int64
+
+	pages := lambda.NewListLayerVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.LayerVersions {
+			versions = append(versions, v.Version)
+		}
+	}
+
+	slices.Sort(versions)
+	slices.Reverse(versions)
+
+	for i, version := range versions {
+		if i < retain || version == publishedVersion {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Lambda Layer Version: %s:%d", layerName, version)
+		_, err := conn.DeleteLayerVersion(ctx, &lambda.DeleteLayerVersionInput{
+			LayerName:     aws.String(layerName),
+			VersionNumber: aws.Int64(version),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting version %d: %w", version, err)
+		}
+	}
+
+	return nil
+}
+
 func layerVersionParseResourceID(id string) (layerName string, version int64, err error) {
 	v, err := arn.Parse(id)
 	if err != nil {
@@ -349,6 +349,82 @@ func TestAccLambdaLayerVersion_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_retainVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // this purposely leaves dangling resources, since skip_destroy = true
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs18.x", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "retain_versions", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs20.x", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					testAccCheckLayerVersionNumberExists(ctx, rName, 1),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs18.x", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:3", rName)),
+					testAccCheckLayerVersionNumberNotExists(ctx, rName, 1),
+					testAccCheckLayerVersionNumberExists(ctx, rName, 2),
+				),
+			},
+			{
+				// Changing the retention alone does not publish a new version.
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs18.x", 1),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:3", rName)),
+					resource.TestCheckResourceAttr(resourceName, "retain_versions", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLayerVersionNumberExists(ctx context.Context, layerName string, versionNumber int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindLayerVersionByTwoPartKey(ctx, conn, layerName, versionNumber)
+
+		return err
+	}
+}
+
+func testAccCheckLayerVersionNumberNotExists(ctx context.Context, layerName string, versionNumber int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindLayerVersionByTwoPartKey(ctx, conn, layerName, versionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Layer Version %s:%d still exists", layerName, versionNumber)
+	}
+}
+
 func testAccCheckLayerVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -535,3 +611,15 @@ resource "aws_lambda_layer_version" "test" {
 }
 `, rName, compatRuntime)
 }
+
+func testAccLayerVersionConfig_retainVersions(rName, compatRuntime string, retainVersions int) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = [%[2]q]
+  retain_versions     = %[3]d
+  skip_destroy        = true
+}
+`, rName, compatRuntime, retainVersions)
+}
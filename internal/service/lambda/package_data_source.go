@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_lambda_package", name="Package")
+func dataSourcePackage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_base64sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"output_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func dataSourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceDir := d.Get("source_dir").(string)
+	outputPath := d.Get("output_path").(string)
+	excludes := flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set))
+
+	archive, err := buildDeterministicZip(sourceDir, excludes)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "packaging Lambda source (%s): %s", sourceDir, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda package (%s) directory: %s", outputPath, err)
+	}
+
+	if err := os.WriteFile(outputPath, archive, 0644); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Lambda package (%s): %s", outputPath, err)
+	}
+
+	hash := sha256.Sum256(archive)
+	outputBase64SHA256 := base64.StdEncoding.EncodeToString(hash[:])
+
+	d.SetId(outputBase64SHA256)
+	d.Set("output_base64sha256", outputBase64SHA256)
+	d.Set("output_size", len(archive))
+
+	return diags
+}
+
+// zipEpoch is the earliest timestamp representable in a zip archive.
+// All entries use it so that the archive does not depend on file modification times.
+var zipEpoch = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// buildDeterministicZip returns a zip archive of the regular files under sourceDir.
+// Entries are sorted by path, timestamps are fixed and permissions are normalized,
+// so the same source content always produces byte-identical output.
+func buildDeterministicZip(sourceDir string, excludes []string) ([]byte, error) {
+	var names []string
+	paths := make(map[string]string)
+
+	err := filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+
+		excluded, err := packageEntryExcluded(name, excludes)
+		if err != nil {
+			return err
+		}
+
+		if excluded {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		names = append(names, name)
+		paths[name] = p
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no files found")
+	}
+
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		if err := addPackageEntry(w, name, paths[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addPackageEntry(w *zip.Writer, name, p string) error {
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: zipEpoch,
+	}
+
+	// Keep only the executable bit, which Lambda needs for custom runtime bootstrap files.
+	if info.Mode()&0111 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+
+	entry, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(entry, f)
+
+	return err
+}
+
+func packageEntryExcluded(name string, excludes []string) (bool, error) {
+	for _, pattern := range excludes {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
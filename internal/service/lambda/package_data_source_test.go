@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBuildDeterministicZip(t *testing.T) {
+	t.Parallel()
+
+	sourceDir := t.TempDir()
+	testAccWritePackageSourceFiles(t, sourceDir)
+
+	first, err := tflambda.BuildDeterministicZip(sourceDir, []string{"*.md"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Touch every file; modification times must not affect the archive.
+	later := time.Now().Add(time.Hour)
+	for _, name := range []string{"index.js", "lib/util.js", "README.md"} {
+		if err := os.Chtimes(filepath.Join(sourceDir, name), later, later); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	second, err := tflambda.BuildDeterministicZip(sourceDir, []string{"*.md"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Fatal("expected identical archives")
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, f := range r.File {
+		got = append(got, f.Name)
+	}
+
+	if want := []string{"index.js", "lib/util.js"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("entries = %v, want %v", got, want)
+	}
+
+	if _, err := tflambda.BuildDeterministicZip(t.TempDir(), nil); err == nil {
+		t.Error("expected error for empty source directory")
+	}
+}
+
+func TestAccLambdaPackageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_package.test"
+	sourceDir := t.TempDir()
+	outputPath := filepath.Join(t.TempDir(), "package.zip")
+	testAccWritePackageSourceFiles(t, sourceDir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageDataSourceConfig_basic(rName, sourceDir, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_base64sha256"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_size"),
+					resource.TestCheckResourceAttrPair("aws_lambda_function.test", "source_code_hash", dataSourceName, "output_base64sha256"),
+					resource.TestCheckResourceAttrPair("aws_lambda_function.test", "code_sha256", dataSourceName, "output_base64sha256"),
+				),
+			},
+			{
+				Config:   testAccPackageDataSourceConfig_basic(rName, sourceDir, outputPath),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccWritePackageSourceFiles(t *testing.T, dir string) {
+	t.Helper()
+
+	files := map[string]string{
+		"index.js":    "exports.handler = async () => require('./lib/util').ok();\n",
+		"lib/util.js": "exports.ok = () => 'ok';\n",
+		"README.md":   "Not packaged.\n",
+	}
+
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func testAccPackageDataSourceConfig_basic(rName, sourceDir, outputPath string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+data "aws_lambda_package" "test" {
+  source_dir  = %[2]q
+  output_path = %[3]q
+  excludes    = ["*.md"]
+}
+
+resource "aws_lambda_function" "test" {
+  filename         = data.aws_lambda_package.test.output_path
+  source_code_hash = data.aws_lambda_package.test.output_base64sha256
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "index.handler"
+  runtime          = "nodejs20.x"
+}
+`, rName, sourceDir, outputPath))
+}
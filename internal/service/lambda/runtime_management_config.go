@@ -200,6 +200,34 @@ func (r *resourceRuntimeManagementConfig) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *resourceRuntimeManagementConfig) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceRuntimeManagementConfigData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.UpdateRuntimeOn.IsUnknown() || config.RuntimeVersionARN.IsUnknown() {
+		return
+	}
+
+	if config.UpdateRuntimeOn.ValueEnum() == awstypes.UpdateRuntimeOnManual {
+		if config.RuntimeVersionARN.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("runtime_version_arn"),
+				"Missing Attribute Configuration",
+				"runtime_version_arn is required when update_runtime_on is Manual",
+			)
+		}
+	} else if !config.RuntimeVersionARN.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("runtime_version_arn"),
+			"Invalid Attribute Combination",
+			"runtime_version_arn can only be set when update_runtime_on is Manual",
+		)
+	}
+}
+
 func (r *resourceRuntimeManagementConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts, err := intflex.ExpandResourceId(req.ID, runtimeManagementConfigIDParts, true)
 	if err != nil {
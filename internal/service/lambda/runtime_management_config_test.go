@@ -131,6 +131,47 @@ func TestAccLambdaRuntimeManagementConfig_runtimeVersionARN(t *testing.T) {
 	})
 }
 
+func TestAccLambdaRuntimeManagementConfig_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var cfg lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuntimeManagementConfigConfig_manualNoRuntimeVersionARN(rName),
+				ExpectError: regexache.MustCompile(`runtime_version_arn is required when update_runtime_on is Manual`),
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_qualifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &cfg),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", functionResourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(types.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRuntimeManagementConfigImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
 func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -233,3 +274,35 @@ resource "aws_lambda_runtime_management_config" "test" {
 }
 `, runtimeVersion))
 }
+
+func testAccRuntimeManagementConfigConfig_manualNoRuntimeVersionARN(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRuntimeManagementConfigConfigBase(rName),
+		`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = "Manual"
+}
+`)
+}
+
+func testAccRuntimeManagementConfigConfig_qualifier(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs18.x"
+  publish       = true
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  qualifier         = aws_lambda_function.test.version
+  update_runtime_on = "FunctionUpdate"
+}
+`, rName))
+}
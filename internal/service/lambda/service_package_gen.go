@@ -64,6 +64,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
 		},
+		{
+			Factory:  dataSourcePackage,
+			TypeName: "aws_lambda_package",
+			Name:     "Package",
+		},
 	}
 }
 
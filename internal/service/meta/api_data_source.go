@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// apiDataReadOnlyOperationPrefixes are the operation name prefixes of the API operations that may be invoked.
+// Only operations that do not modify resources are allowed.
+var apiDataReadOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// @FrameworkDataSource
+func newDataSourceAPIData(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceAPIData{}
+
+	return d, nil
+}
+
+type dataSourceAPIData struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceAPIData) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_api_data"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceAPIData) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"operation": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrParameters: schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"result": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceAPIData) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceAPIDataData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	service, operation := data.Service.ValueString(), data.Operation.ValueString()
+
+	servicePackageName := service
+	if _, err := names.ProviderNameUpper(service); err != nil {
+		servicePackageName, err = names.ProviderPackageForAlias(service)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("service"), "unknown service", err.Error())
+
+			return
+		}
+	}
+
+	client, err := d.Meta().APIClient(ctx, servicePackageName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating %s API client", service), err.Error())
+
+		return
+	}
+
+	result, err := invokeAPIOperation(ctx, client, operation, data.Parameters.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking %s %s", service, operation), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", servicePackageName, operation))
+	data.Result = jsontypes.NewNormalizedValue(result)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceAPIDataData struct {
+	ID         types.String         `tfsdk:"id"`
+	Operation  types.String         `tfsdk:"operation"`
+	Parameters jsontypes.Normalized `tfsdk:"parameters"`
+	Result     jsontypes.Normalized `tfsdk:"result"`
+	Service    types.String         `tfsdk:"service"`
+}
+
+// invokeAPIOperation invokes the named read-only operation on an AWS SDK for Go v2 or v1 API client.
+// The JSON parameters are decoded into the operation's input structure and the output structure is returned as JSON.
+func invokeAPIOperation(ctx context.Context, client any, operation, parameters string) (string, error) {
+	if !isReadOnlyAPIOperation(operation) {
+		return "", fmt.Errorf("operation %q is not a read-only operation, operation names must begin with one of %s", operation, strings.Join(apiDataReadOnlyOperationPrefixes, ", "))
+	}
+
+	v := reflect.ValueOf(client)
+
+	// AWS SDK for Go v1 API clients support Context in the "<Operation>WithContext" methods.
+	m := v.MethodByName(operation + "WithContext")
+	if !m.IsValid() {
+		m = v.MethodByName(operation)
+	}
+	if !m.IsValid() {
+		return "", fmt.Errorf("operation %q not found", operation)
+	}
+
+	// Both AWS SDK for Go v2 and v1 operations have the signature
+	// func(context.Context, *Input, ...Option) (*Output, error).
+	t := m.Type()
+	if t.NumIn() < 2 || !t.In(0).Implements(reflect.TypeFor[context.Context]()) || t.NumOut() != 2 || t.In(1).Kind() != reflect.Pointer || t.In(1).Elem().Kind() != reflect.Struct {
+		return "", fmt.Errorf("operation %q has an unsupported signature", operation)
+	}
+
+	input := reflect.New(t.In(1).Elem())
+	if parameters != "" {
+		decoder := json.NewDecoder(strings.NewReader(parameters))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(input.Interface()); err != nil {
+			return "", fmt.Errorf("decoding parameters: %w", err)
+		}
+	}
+
+	out := m.Call([]reflect.Value{reflect.ValueOf(ctx), input})
+
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return "", err
+	}
+
+	output, err := json.Marshal(out[0].Interface())
+
+	if err != nil {
+		return "", fmt.Errorf("encoding result: %w", err)
+	}
+
+	// Remove AWS SDK for Go v2 operation metadata.
+	var result map[string]any
+	if err := json.Unmarshal(output, &result); err != nil {
+		return "", fmt.Errorf("encoding result: %w", err)
+	}
+	delete(result, "ResultMetadata")
+
+	output, err = json.Marshal(result)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding result: %w", err)
+	}
+
+	return string(output), nil
+}
+
+func isReadOnlyAPIOperation(operation string) bool {
+	for _, prefix := range apiDataReadOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
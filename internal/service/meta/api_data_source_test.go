@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testAPIDataInput struct {
+	Name *string
+}
+
+type testAPIDataOutput struct {
+	Names          []string
+	ResultMetadata struct{}
+}
+
+type testAPIDataClient struct{}
+
+func (testAPIDataClient) DescribeThings(_ context.Context, input *testAPIDataInput, _ ...func(*struct{})) (*testAPIDataOutput, error) {
+	if input.Name == nil {
+		return nil, errors.New("Name is required")
+	}
+
+	return &testAPIDataOutput{Names: []string{*input.Name}}, nil
+}
+
+func (testAPIDataClient) DeleteThing(_ context.Context, _ *testAPIDataInput, _ ...func(*struct{})) (*testAPIDataOutput, error) {
+	return &testAPIDataOutput{}, nil
+}
+
+func TestInvokeAPIOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		operation  string
+		parameters string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			operation:  "DescribeThings",
+			parameters: `{"Name":"test"}`,
+			want:       `{"Names":["test"]}`,
+		},
+		{
+			name:       "operation error",
+			operation:  "DescribeThings",
+			parameters: `{}`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown parameter",
+			operation:  "DescribeThings",
+			parameters: `{"Name":"test","Other":"value"}`,
+			wantErr:    true,
+		},
+		{
+			name:      "unknown operation",
+			operation: "DescribeWidgets",
+			wantErr:   true,
+		},
+		{
+			name:      "not read-only",
+			operation: "DeleteThing",
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfmeta.InvokeAPIOperation(ctx, testAPIDataClient{}, testCase.operation, testCase.parameters)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("InvokeAPIOperation() err %t, want %t: %s", got, want, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("InvokeAPIOperation() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccMetaAPIDataDataSource_v2(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_api_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIDataDataSourceConfig_v2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "sts:GetCallerIdentity"),
+					acctest.CheckResourceAttrJMES(dataSourceName, "result", "Account", acctest.AccountID()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaAPIDataDataSource_v1(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_api_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIDataDataSourceConfig_v1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "directconnect:DescribeLocations"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAPIDataDataSourceConfig_v2 = `
+data "aws_api_data" "test" {
+  service   = "sts"
+  operation = "GetCallerIdentity"
+}
+`
+
+const testAccAPIDataDataSourceConfig_v1 = `
+data "aws_api_data" "test" {
+  service    = "directconnect"
+  operation  = "DescribeLocations"
+  parameters = jsonencode({})
+}
+`
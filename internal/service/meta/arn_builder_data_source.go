@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource
+func newDataSourceARNBuilder(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceARNBuilder{}
+
+	return d, nil
+}
+
+type dataSourceARNBuilder struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*dataSourceARNBuilder) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_arn_builder"
+}
+
+func (d *dataSourceARNBuilder) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"partition": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+			},
+			"resource": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceARNBuilder) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceARNBuilderModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+
+	// The partition is that of the ARN's Region or, for ARNs without a Region, the provider's.
+	partition := data.Partition.ValueString()
+	if partition == "" {
+		if region != "" {
+			partition = names.PartitionForRegion(region)
+		} else {
+			partition = d.Meta().Partition
+		}
+	}
+
+	v := arn.ARN{
+		Partition: partition,
+		Service:   data.Service.ValueString(),
+		Region:    region,
+		AccountID: data.AccountID.ValueString(),
+		Resource:  data.Resource.ValueString(),
+	}.String()
+
+	data.ARN = types.StringValue(v)
+	data.ID = types.StringValue(v)
+	data.Partition = types.StringValue(partition)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceARNBuilderModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	ARN       types.String `tfsdk:"arn"`
+	ID        types.String `tfsdk:"id"`
+	Partition types.String `tfsdk:"partition"`
+	Region    types.String `tfsdk:"region"`
+	Resource  types.String `tfsdk:"resource"`
+	Service   types.String `tfsdk:"service"`
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaARNBuilderDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_arn_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNBuilderDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGlobalARNNoAccount(dataSourceName, names.AttrARN, "s3", "my-bucket/*"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "partition", "data.aws_partition.current", "partition"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNBuilderDataSource_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_arn_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNBuilderDataSourceConfig_region,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrARN, "arn:aws-cn:logs:cn-north-1:123456789012:log-group:example"), // lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws-cn"),
+				),
+			},
+		},
+	})
+}
+
+const testAccARNBuilderDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_arn_builder" "test" {
+  service  = "s3"
+  resource = "my-bucket/*"
+}
+`
+
+// lintignore:AWSAT003
+const testAccARNBuilderDataSourceConfig_region = `
+data "aws_arn_builder" "test" {
+  service    = "logs"
+  region     = "cn-north-1"
+  account_id = "123456789012"
+  resource   = "log-group:example"
+}
+`
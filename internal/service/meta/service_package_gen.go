@@ -13,9 +13,15 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceAPIData,
+		},
 		{
 			Factory: newDataSourceARN,
 		},
+		{
+			Factory: newDataSourceARNBuilder,
+		},
 		{
 			Factory: newDataSourceBillingServiceAccount,
 		},
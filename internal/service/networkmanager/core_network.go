@@ -393,6 +393,41 @@ func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager
 	return output.CoreNetworkPolicy, nil
 }
 
+func findCoreNetworkChangeSetByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) ([]*networkmanager.CoreNetworkChange, error) {
+	input := &networkmanager.GetCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+	var output []*networkmanager.CoreNetworkChange
+
+	err := conn.GetCoreNetworkChangeSetPagesWithContext(ctx, input, func(page *networkmanager.GetCoreNetworkChangeSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreNetworkChanges {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func statusCoreNetworkState(ctx context.Context, conn *networkmanager.NetworkManager, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCoreNetworkByID(ctx, conn, id)
@@ -543,10 +578,21 @@ func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment)
 }
 
 func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string) error {
+	policyVersionID, err := putCoreNetworkPolicy(ctx, conn, coreNetworkId, policyDocument)
+
+	if err != nil {
+		return err
+	}
+
+	return executeCoreNetworkChangeSet(ctx, conn, coreNetworkId, policyVersionID)
+}
+
+// putCoreNetworkPolicy creates a new policy version and waits for its change set to be generated.
+func putCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string) (int64, error) {
 	v, err := protocol.DecodeJSONValue(policyDocument, protocol.NoEscape)
 
 	if err != nil {
-		return fmt.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkId, err)
 	}
 
 	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, &networkmanager.PutCoreNetworkPolicyInput{
@@ -556,16 +602,20 @@ func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Ne
 	})
 
 	if err != nil {
-		return fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
 	}
 
 	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
 
 	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkId, policyVersionID, waitCoreNetworkPolicyCreatedTimeInMinutes*time.Minute); err != nil {
-		return fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
 	}
 
-	_, err = conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+	return policyVersionID, nil
+}
+
+func executeCoreNetworkChangeSet(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionID int64) error {
+	_, err := conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
 		CoreNetworkId:   aws.String(coreNetworkId),
 		PolicyVersionId: aws.Int64(policyVersionID),
 	})
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,7 +33,11 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("execute_change_set", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -38,6 +45,34 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"change_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrIdentifier: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"change_set_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"core_network_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -47,6 +82,11 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^core-network-([0-9a-f]{8,17})$`), "must be a valid Core Network ID"),
 				),
 			},
+			"execute_change_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"policy_document": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,11 +100,27 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 					return json
 				},
 			},
+			"policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("change_set", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("policy_document")
+			}),
+			customdiff.ComputedIf("change_set_state", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("execute_change_set", "policy_document")
+			}),
+			customdiff.ComputedIf("policy_version_id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("policy_document")
+			}),
+		),
 	}
 }
 
@@ -99,7 +155,10 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 	coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
 
 	if tfresource.NotFound(err) {
+		d.Set("change_set", nil)
+		d.Set("change_set_state", nil)
 		d.Set("policy_document", nil)
+		d.Set("policy_version_id", nil)
 	} else if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
 	} else {
@@ -109,7 +168,19 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 			return sdkdiag.AppendErrorf(diags, "encoding Network Manager Core Network (%s) policy document: %s", d.Id(), err)
 		}
 
+		policyVersionID := aws.Int64Value(coreNetworkPolicy.PolicyVersionId)
+		changeSet, err := findCoreNetworkChangeSetByTwoPartKey(ctx, conn, d.Id(), policyVersionID)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) change set (%d): %s", d.Id(), policyVersionID, err)
+		}
+
+		if err := d.Set("change_set", flattenCoreNetworkChanges(changeSet)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting change_set: %s", err)
+		}
+		d.Set("change_set_state", coreNetworkPolicy.ChangeSetState)
 		d.Set("policy_document", encodedPolicyDocument)
+		d.Set("policy_version_id", policyVersionID)
 	}
 	return diags
 }
@@ -119,12 +190,32 @@ func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.Re
 
 	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
 
+	// When execute_change_set is false the new policy version is left ready to execute,
+	// so that its change set can be reviewed before it is applied to the core network.
+	var policyVersionID int64
 	if d.HasChange("policy_document") {
-		err := PutAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
+		var err error
+		policyVersionID, err = putCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+	} else if d.HasChange("execute_change_set") {
+		coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
+		}
+
+		if aws.StringValue(coreNetworkPolicy.ChangeSetState) == networkmanager.ChangeSetStateReadyToExecute {
+			policyVersionID = aws.Int64Value(coreNetworkPolicy.PolicyVersionId)
+		}
+	}
+
+	if policyVersionID >= minimumValidPolicyVersionID && d.Get("execute_change_set").(bool) {
+		if err := executeCoreNetworkChangeSet(ctx, conn, d.Id(), policyVersionID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 
 		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
@@ -133,3 +224,47 @@ func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.Re
 
 	return append(diags, resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)...)
 }
+
+func flattenCoreNetworkChange(apiObject *networkmanager.CoreNetworkChange) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Action; v != nil {
+		tfMap[names.AttrAction] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Identifier; v != nil {
+		tfMap[names.AttrIdentifier] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IdentifierPath; v != nil {
+		tfMap["identifier_path"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap[names.AttrType] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCoreNetworkChanges(apiObjects []*networkmanager.CoreNetworkChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCoreNetworkChange(apiObject))
+	}
+
+	return tfList
+}
@@ -61,6 +61,54 @@ func TestAccNetworkManagerCoreNetworkPolicyAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_executeChangeSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+
+	originalSegmentValue := "segmentValue1"
+	updatedSegmentValue := "segmentValue2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreNetworkPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_executeChangeSet(originalSegmentValue, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "change_set_state", networkmanager.ChangeSetStateExecutionSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "execute_change_set", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_version_id"),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_executeChangeSet(updatedSegmentValue, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "change_set_state", networkmanager.ChangeSetStateReadyToExecute),
+					resource.TestCheckResourceAttr(resourceName, "execute_change_set", acctest.CtFalse),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "change_set.*", map[string]string{
+						names.AttrAction:     networkmanager.ChangeActionAdd,
+						names.AttrIdentifier: updatedSegmentValue,
+						names.AttrType:       networkmanager.ChangeTypeCoreNetworkSegment,
+					}),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_executeChangeSet(updatedSegmentValue, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "change_set_state", networkmanager.ChangeSetStateExecutionSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "execute_change_set", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerCoreNetworkPolicyAttachment_vpcAttachment(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
@@ -210,6 +258,36 @@ resource "aws_networkmanager_core_network_policy_attachment" "test" {
 `, segmentValue, acctest.Region())
 }
 
+func testAccCoreNetworkPolicyAttachmentConfig_executeChangeSet(segmentValue string, executeChangeSet bool) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[2]q
+    }
+  }
+
+  segments {
+    name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id    = aws_networkmanager_core_network.test.id
+  policy_document    = data.aws_networkmanager_core_network_policy_document.test.json
+  execute_change_set = %[3]t
+}
+`, segmentValue, acctest.Region(), executeChangeSet)
+}
+
 func testAccCoreNetworkPolicyAttachmentConfig_vpcAttachmentCreate() string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -26,8 +27,9 @@ func logConfigurationSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"log_group_arn": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
 							},
 						},
 					},
@@ -39,8 +41,9 @@ func logConfigurationSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"delivery_stream_arn": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
 							},
 						},
 					},
@@ -69,8 +72,9 @@ func logConfigurationSchema() *schema.Schema {
 								Required: true,
 							},
 							"bucket_owner": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidAccountID,
 							},
 							"output_format": {
 								Type:             schema.TypeString,
@@ -173,6 +177,15 @@ func expandS3LogDestinationParameters(tfMap map[string]interface{}) *types.S3Log
 	return apiObject
 }
 
+// logConfigurationIsOff returns whether logging is turned off with no destinations,
+// which is how the API reports a log configuration that has been removed.
+func logConfigurationIsOff(apiObject *types.PipeLogConfiguration) bool {
+	return apiObject.Level == types.LogLevelOff &&
+		apiObject.CloudwatchLogsLogDestination == nil &&
+		apiObject.FirehoseLogDestination == nil &&
+		apiObject.S3LogDestination == nil
+}
+
 func flattenPipeLogConfiguration(apiObject *types.PipeLogConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
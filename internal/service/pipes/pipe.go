@@ -209,7 +209,7 @@ func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	} else {
 		d.Set("enrichment_parameters", nil)
 	}
-	if v := output.LogConfiguration; !types.IsZero(v) {
+	if v := output.LogConfiguration; !types.IsZero(v) && !logConfigurationIsOff(v) {
 		if err := d.Set("log_configuration", []interface{}{flattenPipeLogConfiguration(v)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting log_configuration: %s", err)
 		}
@@ -263,12 +263,23 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		if d.HasChange("enrichment_parameters") {
 			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Omitting the parameters leaves them unchanged, so clear them explicitly.
+				input.EnrichmentParameters = &awstypes.PipeEnrichmentParameters{
+					HttpParameters: &awstypes.PipeEnrichmentHttpParameters{},
+					InputTemplate:  aws.String(""),
+				}
 			}
 		}
 
 		if d.HasChange("log_configuration") {
 			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.LogConfiguration = expandPipeLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Logging can't be removed, only turned off.
+				input.LogConfiguration = &awstypes.PipeLogConfigurationParameters{
+					Level: awstypes.LogLevelOff,
+				}
 			}
 		}
 
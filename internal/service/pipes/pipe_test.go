@@ -308,6 +308,14 @@ func TestAccPipesPipe_enrichmentParameters(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.query_string_parameters.%", acctest.Ct0),
 				),
 			},
+			{
+				Config: testAccPipeConfig_enrichment(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttrPair(resourceName, "enrichment", "aws_cloudwatch_event_api_destination.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -391,6 +399,13 @@ func TestAccPipesPipe_update_logConfiguration_cloudwatchLogsLogDestination(t *te
 					resource.TestCheckResourceAttrSet(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.0.log_group_arn"),
 				),
 			},
+			{
+				Config: testAccPipeConfig_basicSQS(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
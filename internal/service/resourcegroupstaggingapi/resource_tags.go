@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesMaxARNs = 20
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesMaxARNs = 100
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_resource_tags", name="Resource Tags")
+func resourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceTagsCreate,
+		ReadWithoutTimeout:   resourceResourceTagsRead,
+		UpdateWithoutTimeout: resourceResourceTagsUpdate,
+		DeleteWithoutTimeout: resourceResourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyLenBetween(1, 128),
+					validation.MapValueLenBetween(0, 256),
+				),
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	slices.Sort(arns)
+	id := strings.Join(arns, flex.ResourceIdSeparator)
+
+	if err := tagResources(ctx, conn, arns, flex.ExpandStringValueMap(d.Get(names.AttrTags).(map[string]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resource Tags (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := strings.Split(d.Id(), flex.ResourceIdSeparator)
+
+	resourceTags, err := findResourceTagsByARNs(ctx, conn, arns)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	d.Set("resource_arns", arns)
+	d.Set(names.AttrTags, managedTags(arns, resourceTags, flex.ExpandStringValueMap(d.Get(names.AttrTags).(map[string]interface{}))))
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	o, n := d.GetChange("resource_arns")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	newARNs := flex.ExpandStringValueSet(ns)
+	removedARNs, keptARNs, addedARNs := flex.ExpandStringValueSet(os.Difference(ns)), flex.ExpandStringValueSet(os.Intersection(ns)), flex.ExpandStringValueSet(ns.Difference(os))
+	slices.Sort(newARNs)
+	id := strings.Join(newARNs, flex.ResourceIdSeparator)
+
+	o, n = d.GetChange(names.AttrTags)
+	oldTags, newTags := flex.ExpandStringValueMap(o.(map[string]interface{})), flex.ExpandStringValueMap(n.(map[string]interface{}))
+
+	// Tags are removed from resources that are no longer managed.
+	if len(removedARNs) > 0 {
+		if err := untagResources(ctx, conn, removedARNs, sortedKeys(oldTags)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	if len(keptARNs) > 0 {
+		var removedKeys []string
+		for k := range oldTags {
+			if _, ok := newTags[k]; !ok {
+				removedKeys = append(removedKeys, k)
+			}
+		}
+
+		if len(removedKeys) > 0 {
+			if err := untagResources(ctx, conn, keptARNs, removedKeys); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange(names.AttrTags) {
+			if err := tagResources(ctx, conn, keptARNs, newTags); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if len(addedARNs) > 0 {
+		if err := tagResources(ctx, conn, addedARNs, newTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := strings.Split(d.Id(), flex.ResourceIdSeparator)
+	keys := sortedKeys(flex.ExpandStringValueMap(d.Get(names.AttrTags).(map[string]interface{})))
+
+	if len(keys) == 0 {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Resource Tags (%s)", d.Id())
+	if err := untagResources(ctx, conn, arns, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := strings.Split(d.Id(), flex.ResourceIdSeparator)
+	slices.Sort(arns)
+	d.SetId(strings.Join(arns, flex.ResourceIdSeparator))
+
+	resourceTags, err := findResourceTagsByARNs(ctx, conn, arns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// All user tags common to every resource are imported as managed tags.
+	var tags map[string]string
+	for _, arn := range arns {
+		v := tftags.New(ctx, resourceTags[arn]).IgnoreAWS().Map()
+
+		if tags == nil {
+			tags = v
+			continue
+		}
+
+		for k, tagValue := range tags {
+			if v[k] != tagValue {
+				delete(tags, k)
+			}
+		}
+	}
+
+	d.Set(names.AttrTags, tags)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// managedTags returns the managed tags as applied to all the resources.
+// A tag that is missing from or has a different value on any resource is reported as missing so that it is reapplied.
+func managedTags(arns []string, resourceTags map[string]map[string]string, tags map[string]string) map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if tfslices.All(arns, func(arn string) bool {
+			tagValue, ok := resourceTags[arn][k]
+			return ok && tagValue == v
+		}) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// sortedKeys returns the map's keys in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
+
+// findResourceTagsByARNs returns the tags of each resource keyed by ARN.
+// Resources without tags are not returned by the Tagging API and are omitted.
+func findResourceTagsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string) (map[string]map[string]string, error) {
+	output := make(map[string]map[string]string, len(arns))
+
+	for _, chunk := range tfslices.Chunks(arns, getResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				output[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags).Map()
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	for _, chunk := range tfslices.Chunks(arns, tagResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: chunk,
+			Tags:            tags,
+		}
+
+		output, err := conn.TagResources(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resources: %w", err)
+		}
+
+		if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+			return fmt.Errorf("tagging resources: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	for _, chunk := range tfslices.Chunks(arns, tagResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: chunk,
+			TagKeys:         keys,
+		}
+
+		output, err := conn.UntagResources(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resources: %w", err)
+		}
+
+		if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+			return fmt.Errorf("untagging resources: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// failedResourcesError returns an error for each resource that the Tagging API could not (un)tag.
+func failedResourcesError(apiObjects map[string]types.FailureInfo) error {
+	var errs []error
+
+	for _, arn := range sortedKeys(apiObjects) {
+		apiObject := apiObjects[arn]
+		errs = append(errs, fmt.Errorf("%s: %s: %s", arn, apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestManagedTags(t *testing.T) {
+	t.Parallel()
+
+	arns := []string{"arn:aws:sqs:us-west-2:123456789012:a", "arn:aws:sqs:us-west-2:123456789012:b"} //lintignore:AWSAT003,AWSAT005
+	tags := map[string]string{
+		acctest.CtKey1: acctest.CtValue1,
+		acctest.CtKey2: acctest.CtValue2,
+	}
+
+	testCases := []struct {
+		name         string
+		resourceTags map[string]map[string]string
+		want         map[string]string
+	}{
+		{
+			name: "in sync",
+			resourceTags: map[string]map[string]string{
+				arns[0]: {acctest.CtKey1: acctest.CtValue1, acctest.CtKey2: acctest.CtValue2, "other": "value"},
+				arns[1]: {acctest.CtKey1: acctest.CtValue1, acctest.CtKey2: acctest.CtValue2},
+			},
+			want: tags,
+		},
+		{
+			name: "value drift",
+			resourceTags: map[string]map[string]string{
+				arns[0]: {acctest.CtKey1: acctest.CtValue1, acctest.CtKey2: acctest.CtValue2},
+				arns[1]: {acctest.CtKey1: acctest.CtValue1Updated, acctest.CtKey2: acctest.CtValue2},
+			},
+			want: map[string]string{acctest.CtKey2: acctest.CtValue2},
+		},
+		{
+			name: "untagged resource",
+			resourceTags: map[string]map[string]string{
+				arns[0]: {acctest.CtKey1: acctest.CtValue1, acctest.CtKey2: acctest.CtValue2},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfresourcegroupstaggingapi.ManagedTags(arns, testCase.resourceTags, tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 2, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					testAccCheckResourceTagsUntag(ctx, "aws_sqs_queue.test.1", acctest.CtKey1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 2, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_bulk(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 25, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "25"),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 3, acctest.CtValue1Updated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroupstaggingapi_resource_tags" {
+				continue
+			}
+
+			arns := strings.Split(rs.Primary.ID, flex.ResourceIdSeparator)
+			resourceTags, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, arns)
+
+			if err != nil {
+				return err
+			}
+
+			for _, arn := range arns {
+				if _, ok := resourceTags[arn][acctest.CtKey1]; ok {
+					return fmt.Errorf("Resource Groups Tagging API Resource Tags %s still exist on %s", rs.Primary.ID, arn)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		arns := strings.Split(rs.Primary.ID, flex.ResourceIdSeparator)
+		resourceTags, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, arns)
+
+		if err != nil {
+			return err
+		}
+
+		for _, arn := range arns {
+			if _, ok := resourceTags[arn][acctest.CtKey1]; !ok {
+				return fmt.Errorf("Resource Groups Tagging API Resource Tags %s not found on %s", rs.Primary.ID, arn)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsUntag(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		_, err := conn.UntagResources(ctx, &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: []string{rs.Primary.Attributes[names.AttrARN]},
+			TagKeys:         []string{key},
+		})
+
+		return err
+	}
+}
+
+func testAccResourceTagsConfig_basic(rName string, count int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_resource_tags" "test" {
+  resource_arns = aws_sqs_queue.test[*].arn
+
+  tags = {
+    key1 = %[3]q
+  }
+}
+`, rName, count, value)
+}
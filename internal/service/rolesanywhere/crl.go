@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rolesanywhere_crl", name="CRL")
+// @Tags(identifierAttribute="arn")
+func ResourceCRL() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCRLCreate,
+		ReadWithoutTimeout:   resourceCRLRead,
+		UpdateWithoutTimeout: resourceCRLUpdate,
+		DeleteWithoutTimeout: resourceCRLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_data": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_anchor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCRLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &rolesanywhere.ImportCrlInput{
+		CrlData:        []byte(d.Get("crl_data").(string)),
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+		TrustAnchorArn: aws.String(d.Get("trust_anchor_arn").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrEnabled); ok {
+		input.Enabled = aws.Bool(v.(bool))
+	}
+
+	output, err := conn.ImportCrl(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Crl.CrlId))
+
+	return append(diags, resourceCRLRead(ctx, d, meta)...)
+}
+
+func resourceCRLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	crl, err := FindCRLByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere CRL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	// crl_data is not read back: the service may store the list in a different encoding than the one configured.
+	d.Set(names.AttrARN, crl.CrlArn)
+	d.Set(names.AttrEnabled, crl.Enabled)
+	d.Set(names.AttrName, crl.Name)
+	d.Set("trust_anchor_arn", crl.TrustAnchorArn)
+
+	return diags
+}
+
+func resourceCRLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	if d.HasChanges("crl_data", names.AttrName) {
+		input := &rolesanywhere.UpdateCrlInput{
+			CrlId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("crl_data") {
+			input.CrlData = []byte(d.Get("crl_data").(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateCrl(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrEnabled) {
+		if d.Get(names.AttrEnabled).(bool) {
+			_, err := conn.EnableCrl(ctx, &rolesanywhere.EnableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling RolesAnywhere CRL (%s): %s", d.Id(), err)
+			}
+		} else {
+			_, err := conn.DisableCrl(ctx, &rolesanywhere.DisableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling RolesAnywhere CRL (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceCRLRead(ctx, d, meta)...)
+}
+
+func resourceCRLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RolesAnywhere CRL (%s)", d.Id())
+	_, err := conn.DeleteCrl(ctx, &rolesanywhere.DeleteCrlInput{
+		CrlId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
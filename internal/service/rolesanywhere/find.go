@@ -65,3 +65,51 @@ func FindTrustAnchorByID(ctx context.Context, conn *rolesanywhere.Client, id str
 
 	return out.TrustAnchor, nil
 }
+
+func FindCRLByID(ctx context.Context, conn *rolesanywhere.Client, id string) (*types.CrlDetail, error) {
+	in := &rolesanywhere.GetCrlInput{
+		CrlId: aws.String(id),
+	}
+
+	out, err := conn.GetCrl(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Crl == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Crl, nil
+}
+
+func FindProfileByName(ctx context.Context, conn *rolesanywhere.Client, name string) (*types.ProfileDetail, error) {
+	in := &rolesanywhere.ListProfilesInput{}
+	var out []types.ProfileDetail
+
+	pages := rolesanywhere.NewListProfilesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Profiles {
+			if aws.ToString(v.Name) == name {
+				out = append(out, v)
+			}
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(out)
+}
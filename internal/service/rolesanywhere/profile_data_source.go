@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_rolesanywhere_profile", name="Profile")
+func DataSourceProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"managed_policy_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"require_instance_properties": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"role_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"session_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	profile, err := FindProfileByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("RolesAnywhere Profile", err))
+	}
+
+	d.SetId(aws.ToString(profile.ProfileId))
+	d.Set(names.AttrARN, profile.ProfileArn)
+	d.Set("duration_seconds", profile.DurationSeconds)
+	d.Set(names.AttrEnabled, profile.Enabled)
+	d.Set("managed_policy_arns", profile.ManagedPolicyArns)
+	d.Set(names.AttrName, profile.Name)
+	d.Set("require_instance_properties", profile.RequireInstanceProperties)
+	d.Set("role_arns", profile.RoleArns)
+	d.Set("session_policy", profile.SessionPolicy)
+
+	return diags
+}
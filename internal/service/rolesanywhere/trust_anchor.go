@@ -6,6 +6,7 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -22,6 +25,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// notificationSettingConfiguredByService is the principal of notification settings that have their default values.
+	notificationSettingConfiguredByService = "rolesanywhere.amazonaws.com"
+)
+
 // @SDKResource("aws_rolesanywhere_trust_anchor", name="Trust Anchor")
 // @Tags(identifierAttribute="arn")
 func ResourceTrustAnchor() *schema.Resource {
@@ -49,6 +57,41 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      notificationSettingHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.NotificationChannelAll,
+							ValidateDiagFunc: enum.Validate[types.NotificationChannel](),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"event": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationEvent](),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			names.AttrSource: {
 				Type:     schema.TypeList,
 				Required: true,
@@ -103,6 +146,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -135,6 +182,10 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrEnabled, trustAnchor.Enabled)
 	d.Set(names.AttrName, trustAnchor.Name)
 
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_settings: %s", err)
+	}
+
 	if err := d.Set(names.AttrSource, flattenSource(trustAnchor.Source)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
 	}
@@ -146,7 +197,39 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			// Settings that are no longer configured return to the service defaults.
+			input := &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: expandNotificationSettingKeys(del.List()),
+				TrustAnchorId:           aws.String(d.Id()),
+			}
+
+			_, err := conn.ResetNotificationSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+
+		if ns.Len() > 0 {
+			input := &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: expandNotificationSettings(ns.List()),
+				TrustAnchorId:        aws.String(d.Id()),
+			}
+
+			_, err := conn.PutNotificationSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges(names.AttrEnabled, names.AttrName, names.AttrSource) {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get(names.AttrName).(string)),
@@ -279,6 +362,84 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 	return result
 }
 
+func notificationSettingHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s", tfMap["event"].(string), tfMap["channel"].(string)))
+}
+
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNotificationSettingKeys(tfList []interface{}) []types.NotificationSettingKey {
+	var apiObjects []types.NotificationSettingKey
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSettingKey{
+			Event: types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenNotificationSettingDetails returns the notification settings that differ from the service defaults.
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.ConfiguredBy) == notificationSettingConfiguredByService {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":         string(apiObject.Channel),
+			"configured_by":   aws.StringValue(apiObject.ConfiguredBy),
+			names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+			"event":           string(apiObject.Event),
+			"threshold":       aws.Int32Value(apiObject.Threshold),
+		})
+	}
+
+	return tfList
+}
+
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
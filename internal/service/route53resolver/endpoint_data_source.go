@@ -56,6 +56,14 @@ func DataSourceEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"outpost_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preferred_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"protocols": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -127,6 +135,8 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set(names.AttrARN, ep.Arn)
 	d.Set("direction", ep.Direction)
 	d.Set(names.AttrName, ep.Name)
+	d.Set("outpost_arn", ep.OutpostArn)
+	d.Set("preferred_instance_type", ep.PreferredInstanceType)
 	d.Set("protocols", aws.StringValueSlice(ep.Protocols))
 	d.Set("resolver_endpoint_id", ep.Id)
 	d.Set("resolver_endpoint_type", ep.ResolverEndpointType)
@@ -5,5 +5,6 @@ package route53resolver
 
 // Exports for use in tests only.
 var (
-	ValidResolverName = validResolverName
+	FindOutpostResolverByID = findOutpostResolverByID
+	ValidResolverName       = validResolverName
 )
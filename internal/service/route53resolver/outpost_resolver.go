@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_route53_resolver_outpost_resolver", name="Outpost Resolver")
+// @Tags(identifierAttribute="arn")
+func ResourceOutpostResolver() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOutpostResolverCreate,
+		ReadWithoutTimeout:   resourceOutpostResolverRead,
+		UpdateWithoutTimeout: resourceOutpostResolverUpdate,
+		DeleteWithoutTimeout: resourceOutpostResolverDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"preferred_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOutpostResolverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &route53resolver.CreateOutpostResolverInput{
+		CreatorRequestId:      aws.String(id.PrefixedUniqueId("tf-r53-resolver-outpost-resolver-")),
+		Name:                  aws.String(name),
+		OutpostArn:            aws.String(d.Get("outpost_arn").(string)),
+		PreferredInstanceType: aws.String(d.Get("preferred_instance_type").(string)),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrInstanceCount); ok {
+		input.InstanceCount = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateOutpostResolverWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route53 Resolver Outpost Resolver (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OutpostResolver.Id))
+
+	if _, err := waitOutpostResolverCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Outpost Resolver (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceOutpostResolverRead(ctx, d, meta)...)
+}
+
+func resourceOutpostResolverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+
+	resolver, err := findOutpostResolverByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Resolver Outpost Resolver (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Resolver Outpost Resolver (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, resolver.Arn)
+	d.Set(names.AttrInstanceCount, resolver.InstanceCount)
+	d.Set(names.AttrName, resolver.Name)
+	d.Set("outpost_arn", resolver.OutpostArn)
+	d.Set("preferred_instance_type", resolver.PreferredInstanceType)
+	d.Set(names.AttrStatus, resolver.Status)
+	d.Set(names.AttrStatusMessage, resolver.StatusMessage)
+
+	return diags
+}
+
+func resourceOutpostResolverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+
+	if d.HasChanges(names.AttrInstanceCount, names.AttrName, "preferred_instance_type") {
+		input := &route53resolver.UpdateOutpostResolverInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrInstanceCount) {
+			input.InstanceCount = aws.Int64(int64(d.Get(names.AttrInstanceCount).(int)))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange("preferred_instance_type") {
+			input.PreferredInstanceType = aws.String(d.Get("preferred_instance_type").(string))
+		}
+
+		_, err := conn.UpdateOutpostResolverWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Outpost Resolver (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitOutpostResolverUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Outpost Resolver (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOutpostResolverRead(ctx, d, meta)...)
+}
+
+func resourceOutpostResolverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Route53 Resolver Outpost Resolver: %s", d.Id())
+	_, err := conn.DeleteOutpostResolverWithContext(ctx, &route53resolver.DeleteOutpostResolverInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route53 Resolver Outpost Resolver (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOutpostResolverDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Outpost Resolver (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOutpostResolverByID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.OutpostResolver, error) {
+	input := &route53resolver.GetOutpostResolverInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOutpostResolverWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OutpostResolver == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OutpostResolver, nil
+}
+
+func statusOutpostResolver(ctx context.Context, conn *route53resolver.Route53Resolver, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOutpostResolverByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitOutpostResolverCreated(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{route53resolver.OutpostResolverStatusCreating},
+		Target:     []string{route53resolver.OutpostResolverStatusOperational},
+		Refresh:    statusOutpostResolver(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutpostResolverUpdated(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{route53resolver.OutpostResolverStatusUpdating},
+		Target:     []string{route53resolver.OutpostResolverStatusOperational},
+		Refresh:    statusOutpostResolver(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutpostResolverDeleted(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{route53resolver.OutpostResolverStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusOutpostResolver(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
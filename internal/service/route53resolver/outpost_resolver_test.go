@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53resolver "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ResolverOutpostResolver_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.OutpostResolver
+	resourceName := "aws_route53_resolver_outpost_resolver.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutpostResolverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostResolverConfig_basic(rName, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOutpostResolverExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceCount, acctest.Ct4),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "outpost_arn", "data.aws_outposts_outpost.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "preferred_instance_type", "data.aws_outposts_outpost_instance_type.test", names.AttrInstanceType),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, route53resolver.OutpostResolverStatusOperational),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOutpostResolverConfig_basic(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutpostResolverExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceCount, "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverOutpostResolver_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.OutpostResolver
+	resourceName := "aws_route53_resolver_outpost_resolver.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutpostResolverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostResolverConfig_basic(rName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutpostResolverExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53resolver.ResourceOutpostResolver(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutpostResolverDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_resolver_outpost_resolver" {
+				continue
+			}
+
+			_, err := tfroute53resolver.FindOutpostResolverByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route53 Resolver Outpost Resolver still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOutpostResolverExists(ctx context.Context, n string, v *route53resolver.OutpostResolver) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
+
+		output, err := tfroute53resolver.FindOutpostResolverByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOutpostResolverConfig_basic(rName string, instanceCount int) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+data "aws_outposts_outpost_instance_types" "test" {
+  arn = data.aws_outposts_outpost.test.arn
+}
+
+data "aws_outposts_outpost_instance_type" "test" {
+  arn                      = data.aws_outposts_outpost.test.arn
+  preferred_instance_types = data.aws_outposts_outpost_instance_types.test.instance_types
+}
+
+resource "aws_route53_resolver_outpost_resolver" "test" {
+  name                    = %[1]q
+  instance_count          = %[2]d
+  outpost_arn             = data.aws_outposts_outpost.test.arn
+  preferred_instance_type = data.aws_outposts_outpost_instance_type.test.instance_type
+}
+`, rName, instanceCount)
+}
@@ -115,6 +115,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceOutpostResolver,
+			TypeName: "aws_route53_resolver_outpost_resolver",
+			Name:     "Outpost Resolver",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceQueryLogConfig,
 			TypeName: "aws_route53_resolver_query_log_config",
@@ -65,6 +65,11 @@ func RegisterSweepers() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_route53_resolver_outpost_resolver", &resource.Sweeper{
+		Name: "aws_route53_resolver_outpost_resolver",
+		F:    sweepOutpostResolvers,
+	})
+
 	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogConfigAssociations,
@@ -439,6 +444,50 @@ func sweepFirewallRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepOutpostResolvers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.Route53ResolverConn(ctx)
+	input := &route53resolver.ListOutpostResolversInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListOutpostResolversPagesWithContext(ctx, input, func(page *route53resolver.ListOutpostResolversOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OutpostResolvers {
+			r := ResourceOutpostResolver()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route53 Resolver Outpost Resolver sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route53 Resolver Outpost Resolvers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route53 Resolver Outpost Resolvers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepQueryLogConfigAssociations(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -4,8 +4,12 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -16,13 +20,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/ManageCorsUsing.html.
+	corsRulesMaxItems = 100
+)
+
 // @SDKResource("aws_s3_bucket_cors_configuration", name="Bucket CORS Configuration")
 func resourceBucketCorsConfiguration() *schema.Resource {
 	return &schema.Resource{
@@ -51,13 +62,14 @@ func resourceBucketCorsConfiguration() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeSet,
 				Required: true,
-				MaxItems: 100,
+				MaxItems: corsRulesMaxItems,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						"allowed_methods": {
 							Type:     schema.TypeSet,
@@ -73,6 +85,7 @@ func resourceBucketCorsConfiguration() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						names.AttrID: {
 							Type:         schema.TypeString,
@@ -85,8 +98,11 @@ func resourceBucketCorsConfiguration() *schema.Resource {
 						},
 					},
 				},
+				Set: corsRuleHash,
 			},
 		},
+
+		CustomizeDiff: bucketCORSConfigurationCustomizeDiff,
 	}
 }
 
@@ -226,6 +242,20 @@ func resourceBucketCorsConfigurationDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
+// bucketCORSConfigurationCustomizeDiff enforces the S3 limit on the number of CORS rules once
+// rules generated by dynamic blocks are known.
+func bucketCORSConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cors_rule") {
+		return nil
+	}
+
+	if n := d.Get("cors_rule").(*schema.Set).Len(); n > corsRulesMaxItems {
+		return fmt.Errorf("a CORS configuration can contain at most %d rules, got %d", corsRulesMaxItems, n)
+	}
+
+	return nil
+}
+
 func findCORSRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.CORSRule, error) {
 	input := &s3.GetBucketCorsInput{
 		Bucket: aws.String(bucket),
@@ -332,3 +362,35 @@ func flattenCORSRules(rules []types.CORSRule) []interface{} {
 
 	return results
 }
+
+// corsRuleHash hashes a CORS rule, ignoring the case of header names.
+// HTTP header names are case insensitive, so rules that differ only in header case are equivalent.
+func corsRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	for _, key := range []string{"allowed_headers", "allowed_methods", "allowed_origins", "expose_headers"} {
+		if v, ok := m[key].(*schema.Set); ok {
+			values := flex.ExpandStringValueSet(v)
+			if key == "allowed_headers" || key == "expose_headers" {
+				for i, v := range values {
+					values[i] = strings.ToLower(v)
+				}
+			}
+			slices.Sort(values)
+			buf.WriteString(fmt.Sprintf("%s-", strings.Join(values, ",")))
+		}
+	}
+	if v, ok := m[names.AttrID].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+	if v, ok := m["max_age_seconds"].(int); ok {
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	}
+
+	return create.StringHashcode(buf.String())
+}
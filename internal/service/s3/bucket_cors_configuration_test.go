@@ -9,6 +9,9 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -349,6 +352,83 @@ func TestAccS3BucketCORSConfiguration_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketCORSConfiguration_headerCase(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSConfigurationConfig_headers(rName, "Content-Type", "ETag"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors_rule.*.allowed_headers.*", "Content-Type"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors_rule.*.expose_headers.*", "ETag"),
+				),
+			},
+			{
+				Config:   testAccBucketCORSConfigurationConfig_headers(rName, "content-type", "etag"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketCORSConfiguration_outOfBandRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSConfigurationExists(ctx, resourceName),
+					testAccCheckBucketCORSConfigurationAddRule(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketCORSConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSConfigurationRuleCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors_rule.*.allowed_methods.*", "PUT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketCORSConfiguration_tooManyRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketCORSConfigurationConfig_rules(rName, 101),
+				ExpectError: regexache.MustCompile(`(Too many cors_rule blocks|at most 100 rules)`),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketCORSConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
@@ -400,6 +480,69 @@ func testAccCheckBucketCORSConfigurationExists(ctx context.Context, n string) re
 	}
 }
 
+// testAccCheckBucketCORSConfigurationAddRule adds a CORS rule outside of Terraform.
+func testAccCheckBucketCORSConfigurationAddRule(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		corsRules, err := tfs3.FindCORSRules(ctx, conn, bucket, expectedBucketOwner)
+		if err != nil {
+			return err
+		}
+
+		corsRules = append(corsRules, types.CORSRule{
+			AllowedMethods: []string{"GET"},
+			AllowedOrigins: []string{"https://out-of-band.example.com"},
+		})
+
+		_, err = conn.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+			Bucket: aws.String(bucket),
+			CORSConfiguration: &types.CORSConfiguration{
+				CORSRules: corsRules,
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccCheckBucketCORSConfigurationRuleCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		corsRules, err := tfs3.FindCORSRules(ctx, conn, bucket, expectedBucketOwner)
+		if err != nil {
+			return err
+		}
+
+		if got := len(corsRules); got != want {
+			return fmt.Errorf("S3 Bucket CORS Configuration (%s) has %d rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccBucketCORSConfigurationConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -518,3 +661,43 @@ resource "aws_s3_bucket_cors_configuration" "test" {
 }
 `)
 }
+
+func testAccBucketCORSConfigurationConfig_headers(rName, allowedHeader, exposeHeader string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  cors_rule {
+    allowed_headers = [%[2]q]
+    allowed_methods = ["PUT"]
+    allowed_origins = ["https://www.example.com"]
+    expose_headers  = [%[3]q]
+  }
+}
+`, rName, allowedHeader, exposeHeader)
+}
+
+func testAccBucketCORSConfigurationConfig_rules(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  dynamic "cors_rule" {
+    for_each = range(%[2]d)
+
+    content {
+      allowed_methods = ["GET"]
+      allowed_origins = ["https://${cors_rule.value}.example.com"]
+    }
+  }
+}
+`, rName, n)
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -43,10 +45,150 @@ func dataSourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_configuration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"object_lock_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRule: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												names.AttrMode: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"years": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrRegion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRule: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mfa_delete": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"website_domain": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,5 +251,89 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[WARN] Reading S3 Bucket (%s) Website: %s", bucket, err)
 	}
 
+	if d.Get("include_configuration").(bool) {
+		diags = append(diags, dataSourceBucketReadConfiguration(ctx, d, conn, bucket)...)
+	}
+
+	return diags
+}
+
+// dataSourceBucketReadConfiguration reads the bucket's subresource configurations.
+// Subresources that aren't configured are left empty.
+func dataSourceBucketReadConfiguration(ctx context.Context, d *schema.ResourceData, conn *s3.Client, bucket string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if rules, err := findLifecycleRules(ctx, conn, bucket, ""); err == nil {
+		d.Set("lifecycle_rule", flattenBucketLifecycleRuleSummaries(rules))
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
+	}
+
+	if objectLockConfiguration, err := findObjectLockConfiguration(ctx, conn, bucket, ""); err == nil {
+		if err := d.Set("object_lock_configuration", flattenObjectLockConfiguration(objectLockConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting object_lock_configuration: %s", err)
+		}
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object Lock Configuration: %s", bucket, err)
+	}
+
+	if policy, err := findBucketPolicy(ctx, conn, bucket); err == nil {
+		d.Set(names.AttrPolicy, policy)
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Policy: %s", bucket, err)
+	}
+
+	if publicAccessBlock, err := findPublicAccessBlockConfiguration(ctx, conn, bucket); err == nil {
+		if err := d.Set("public_access_block", []interface{}{flattenBucketPublicAccessBlockConfiguration(publicAccessBlock)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting public_access_block: %s", err)
+		}
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Public Access Block: %s", bucket, err)
+	}
+
+	if encryptionConfiguration, err := findServerSideEncryptionConfiguration(ctx, conn, bucket, ""); err == nil {
+		if err := d.Set("server_side_encryption_configuration", flattenBucketServerSideEncryptionConfiguration(encryptionConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_configuration: %s", err)
+		}
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Server-side Encryption Configuration: %s", bucket, err)
+	}
+
+	if versioning, err := findBucketVersioning(ctx, conn, bucket, ""); err == nil {
+		if err := d.Set("versioning", flattenBucketVersioning(versioning)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting versioning: %s", err)
+		}
+	} else if !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Versioning: %s", bucket, err)
+	}
+
 	return diags
 }
+
+func flattenBucketLifecycleRuleSummaries(rules []types.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, rule := range rules {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:     aws.ToString(rule.ID),
+			names.AttrStatus: rule.Status,
+		})
+	}
+
+	return tfList
+}
+
+func flattenBucketPublicAccessBlockConfiguration(apiObject *types.PublicAccessBlockConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"block_public_acls":       aws.ToBool(apiObject.BlockPublicAcls),
+		"block_public_policy":     aws.ToBool(apiObject.BlockPublicPolicy),
+		"ignore_public_acls":      aws.ToBool(apiObject.IgnorePublicAcls),
+		"restrict_public_buckets": aws.ToBool(apiObject.RestrictPublicBuckets),
+	}
+
+	return tfMap
+}
@@ -107,6 +107,38 @@ func TestAccS3BucketDataSource_accessPointAlias(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketDataSource_includeConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketDataSourceConfig_includeConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_configuration.0.object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPolicy, "aws_s3_bucket_policy.test", names.AttrPolicy),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.restrict_public_buckets", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccBucketDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -119,6 +151,91 @@ data "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketDataSourceConfig_includeConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "expire"
+    status = "Enabled"
+
+    filter {}
+
+    expiration {
+      days = 365
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowAccount"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+      Action    = "s3:GetObject"
+      Resource  = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+
+  depends_on = [aws_s3_bucket_public_access_block.test]
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  include_configuration = true
+
+  depends_on = [
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_public_access_block.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_versioning.test,
+  ]
+}
+`, rName)
+}
+
 func testAccBucketDataSourceConfig_website(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_eventbridge_notification", name="Bucket EventBridge Notification")
+func resourceBucketEventBridgeNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketEventBridgeNotificationCreate,
+		ReadWithoutTimeout:   resourceBucketEventBridgeNotificationRead,
+		DeleteWithoutTimeout: resourceBucketEventBridgeNotificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBucketEventBridgeNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+
+	_, err := tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return nil, modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+			notificationConfiguration.EventBridgeConfiguration = &types.EventBridgeConfiguration{}
+
+			return nil
+		})
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) EventBridge Notification: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketEventBridgeConfiguration(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket EventBridge Notification (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketEventBridgeNotificationRead(ctx, d, meta)...)
+}
+
+func resourceBucketEventBridgeNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	_, err := findBucketEventBridgeConfiguration(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket EventBridge Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket EventBridge Notification (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, d.Id())
+
+	return diags
+}
+
+func resourceBucketEventBridgeNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	log.Printf("[DEBUG] Deleting S3 Bucket EventBridge Notification: %s", d.Id())
+	err := modifyBucketNotificationConfiguration(ctx, conn, d.Id(), func(notificationConfiguration *types.NotificationConfiguration) error {
+		notificationConfiguration.EventBridgeConfiguration = nil
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket EventBridge Notification (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketEventBridgeConfiguration(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket EventBridge Notification (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBucketEventBridgeConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.EventBridgeConfiguration, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EventBridgeConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(bucket)
+	}
+
+	return output.EventBridgeConfiguration, nil
+}
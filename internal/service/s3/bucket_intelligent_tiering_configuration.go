@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -36,6 +37,8 @@ func resourceBucketIntelligentTieringConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketIntelligentTieringConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:     schema.TypeString,
@@ -85,8 +88,9 @@ func resourceBucketIntelligentTieringConfiguration() *schema.Resource {
 							ValidateDiagFunc: enum.Validate[types.IntelligentTieringAccessTier](),
 						},
 						"days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(intelligentTieringArchiveAccessMinimumDays, intelligentTieringMaximumDays),
 						},
 					},
 				},
@@ -219,6 +223,51 @@ func resourceBucketIntelligentTieringConfigurationDelete(ctx context.Context, d
 	return diags
 }
 
+const (
+	// Objects can be moved to the Archive Access tier after 90 to 730 consecutive days without access
+	// and to the Deep Archive Access tier after 180 to 730 days.
+	intelligentTieringArchiveAccessMinimumDays     = 90
+	intelligentTieringDeepArchiveAccessMinimumDays = 180
+	intelligentTieringMaximumDays                  = 730
+)
+
+func resourceBucketIntelligentTieringConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	days := make(map[types.IntelligentTieringAccessTier]int)
+
+	for _, tfMapRaw := range d.Get("tiering").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier, v := types.IntelligentTieringAccessTier(tfMap["access_tier"].(string)), tfMap["days"].(int)
+
+		if accessTier == "" {
+			continue
+		}
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf("tiering: access_tier %s is specified more than once", accessTier)
+		}
+
+		if accessTier == types.IntelligentTieringAccessTierDeepArchiveAccess && v != 0 && v < intelligentTieringDeepArchiveAccessMinimumDays {
+			return fmt.Errorf("tiering: days for access_tier %s must be at least %d, got %d", accessTier, intelligentTieringDeepArchiveAccessMinimumDays, v)
+		}
+
+		days[accessTier] = v
+	}
+
+	archiveDays, ok1 := days[types.IntelligentTieringAccessTierArchiveAccess]
+	deepArchiveDays, ok2 := days[types.IntelligentTieringAccessTierDeepArchiveAccess]
+
+	if ok1 && ok2 && archiveDays != 0 && deepArchiveDays != 0 && deepArchiveDays <= archiveDays {
+		return fmt.Errorf("tiering: days for access_tier %s (%d) must be greater than days for access_tier %s (%d)", types.IntelligentTieringAccessTierDeepArchiveAccess, deepArchiveDays, types.IntelligentTieringAccessTierArchiveAccess, archiveDays)
+	}
+
+	return nil
+}
+
 const bucketIntelligentTieringConfigurationResourceIDSeparator = ":"
 
 func BucketIntelligentTieringConfigurationCreateResourceID(bucketName, configurationName string) string {
@@ -264,6 +313,39 @@ func findIntelligentTieringConfiguration(ctx context.Context, conn *s3.Client, b
 	return output.IntelligentTieringConfiguration, nil
 }
 
+func findIntelligentTieringConfigurations(ctx context.Context, conn *s3.Client, bucket string) ([]types.IntelligentTieringConfiguration, error) {
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+	var output []types.IntelligentTieringConfiguration
+
+	// The AWS SDK for Go v2 does not provide a ListBucketIntelligentTieringConfigurations paginator.
+	for {
+		page, err := conn.ListBucketIntelligentTieringConfigurations(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.IntelligentTieringConfigurationList...)
+
+		if !aws.ToBool(page.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
+
 func expandIntelligentTieringFilter(ctx context.Context, tfMap map[string]interface{}) *types.IntelligentTieringFilter {
 	if tfMap == nil {
 		return nil
@@ -214,6 +214,79 @@ func TestAccS3BucketIntelligentTieringConfiguration_directoryBucket(t *testing.T
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_tieringInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, 90, 120),
+				ExpectError: regexache.MustCompile(`days for access_tier DEEP_ARCHIVE_ACCESS must be at least 180`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, 200, 180),
+				ExpectError: regexache.MustCompile(`days for access_tier DEEP_ARCHIVE_ACCESS \(180\) must be greater than days for access_tier ARCHIVE_ACCESS \(200\)`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfiguration_tiering(t *testing.T) {
+	ctx := acctest.Context(t)
+	var itc types.IntelligentTieringConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, 90, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "ARCHIVE_ACCESS",
+						"days":        "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, 365, 730),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "ARCHIVE_ACCESS",
+						"days":        "365",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "730",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketIntelligentTieringConfigurationExists(ctx context.Context, n string, v *types.IntelligentTieringConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -289,6 +362,33 @@ resource "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketIntelligentTieringConfigurationConfig_tiering(rName string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    prefix = "archive/"
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[2]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, archiveDays, deepArchiveDays)
+}
+
 func testAccBucketIntelligentTieringConfigurationConfig_filterPrefix(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
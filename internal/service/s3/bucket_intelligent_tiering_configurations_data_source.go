@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3_bucket_intelligent_tiering_configurations", name="Bucket Intelligent-Tiering Configurations")
+func newBucketIntelligentTieringConfigurationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &bucketIntelligentTieringConfigurationsDataSource{}
+
+	return d, nil
+}
+
+type bucketIntelligentTieringConfigurationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *bucketIntelligentTieringConfigurationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket_intelligent_tiering_configurations"
+}
+
+func (d *bucketIntelligentTieringConfigurationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"configurations": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[intelligentTieringConfigurationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[intelligentTieringConfigurationModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *bucketIntelligentTieringConfigurationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data bucketIntelligentTieringConfigurationsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3Client(ctx)
+
+	bucket := data.Bucket.ValueString()
+	output, err := findIntelligentTieringConfigurations(ctx, conn, bucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing S3 Bucket (%s) Intelligent-Tiering Configurations", bucket), err.Error())
+
+		return
+	}
+
+	var configurations []intelligentTieringConfigurationModel
+	for _, apiObject := range output {
+		configuration := intelligentTieringConfigurationModel{
+			Filter: fwtypes.NewListNestedObjectValueOfNull[intelligentTieringFilterModel](ctx),
+			Name:   types.StringPointerValue(apiObject.Id),
+			Status: fwtypes.StringEnumValue(apiObject.Status),
+		}
+
+		if v := apiObject.Filter; v != nil {
+			configuration.Filter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, flattenIntelligentTieringFilterModel(ctx, v))
+		}
+
+		var tierings []tieringModel
+		for _, v := range apiObject.Tierings {
+			tierings = append(tierings, tieringModel{
+				AccessTier: fwtypes.StringEnumValue(v.AccessTier),
+				Days:       types.Int64Value(int64(aws.ToInt32(v.Days))),
+			})
+		}
+		configuration.Tierings = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tierings)
+
+		configurations = append(configurations, configuration)
+	}
+
+	data.Configurations = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, configurations)
+	data.ID = types.StringValue(bucket)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenIntelligentTieringFilterModel(ctx context.Context, apiObject *awstypes.IntelligentTieringFilter) *intelligentTieringFilterModel {
+	tfMap := flattenIntelligentTieringFilter(ctx, apiObject)
+	data := &intelligentTieringFilterModel{
+		Prefix: types.StringNull(),
+		Tags:   fwtypes.NewMapValueOfNull[types.String](ctx),
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok {
+		data.Prefix = types.StringValue(v)
+	}
+
+	if v, ok := tfMap[names.AttrTags].(map[string]string); ok {
+		elements := make(map[string]attr.Value, len(v))
+		for k, v := range v {
+			elements[k] = types.StringValue(v)
+		}
+		data.Tags = fwtypes.NewMapValueOfMust[types.String](ctx, elements)
+	}
+
+	return data
+}
+
+type bucketIntelligentTieringConfigurationsDataSourceModel struct {
+	Bucket         types.String                                                          `tfsdk:"bucket"`
+	Configurations fwtypes.ListNestedObjectValueOf[intelligentTieringConfigurationModel] `tfsdk:"configurations"`
+	ID             types.String                                                          `tfsdk:"id"`
+}
+
+type intelligentTieringConfigurationModel struct {
+	Filter   fwtypes.ListNestedObjectValueOf[intelligentTieringFilterModel] `tfsdk:"filter"`
+	Name     types.String                                                   `tfsdk:"name"`
+	Status   fwtypes.StringEnum[awstypes.IntelligentTieringStatus]          `tfsdk:"status"`
+	Tierings fwtypes.ListNestedObjectValueOf[tieringModel]                  `tfsdk:"tiering"`
+}
+
+type intelligentTieringFilterModel struct {
+	Prefix types.String                     `tfsdk:"prefix"`
+	Tags   fwtypes.MapValueOf[types.String] `tfsdk:"tags"`
+}
+
+type tieringModel struct {
+	AccessTier fwtypes.StringEnum[awstypes.IntelligentTieringAccessTier] `tfsdk:"access_tier"`
+	Days       types.Int64                                               `tfsdk:"days"`
+}
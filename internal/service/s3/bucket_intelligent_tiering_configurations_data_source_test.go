@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketIntelligentTieringConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_intelligent_tiering_configurations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(dataSourceName, "configurations.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"filter.#":              acctest.Ct0,
+						names.AttrName:          rName + "-1",
+						names.AttrStatus:        "Enabled",
+						"tiering.#":             acctest.Ct1,
+						"tiering.0.access_tier": "DEEP_ARCHIVE_ACCESS",
+						"tiering.0.days":        "180",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"filter.#":          acctest.Ct1,
+						"filter.0.prefix":   "archive/",
+						"filter.0.tags.%":   acctest.Ct1,
+						"filter.0.tags.Env": "test",
+						names.AttrName:      rName + "-2",
+						names.AttrStatus:    "Disabled",
+						"tiering.#":         acctest.Ct2,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-1"
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-2"
+  status = "Disabled"
+
+  filter {
+    prefix = "archive/"
+
+    tags = {
+      Env = "test"
+    }
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 365
+  }
+}
+
+data "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [
+    aws_s3_bucket_intelligent_tiering_configuration.test1,
+    aws_s3_bucket_intelligent_tiering_configuration.test2,
+  ]
+}
+`, rName)
+}
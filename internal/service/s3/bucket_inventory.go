@@ -5,11 +5,14 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -38,6 +41,8 @@ func resourceBucketInventory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketInventoryCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:     schema.TypeString,
@@ -240,6 +245,10 @@ func resourceBucketInventoryPut(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("destination.0.bucket.0.bucket_arn") {
+		diags = append(diags, inventoryDestinationPolicyWarnings(ctx, meta.(*conns.AWSClient), d.Get("destination.0.bucket.0.bucket_arn").(string))...)
+	}
+
 	return append(diags, resourceBucketInventoryRead(ctx, d, meta)...)
 }
 
@@ -323,6 +332,127 @@ func resourceBucketInventoryDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceBucketInventoryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("destination.0.bucket.0.bucket_arn") {
+		return nil
+	}
+
+	bucketARN := d.Get("destination.0.bucket.0.bucket_arn").(string)
+
+	if bucketARN == "" {
+		return nil
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+
+	v, err := arn.Parse(bucketARN)
+
+	if err != nil {
+		return fmt.Errorf("destination.0.bucket.0.bucket_arn: %w", err)
+	}
+
+	// Inventory reports can't be delivered across partitions.
+	if v.Partition != awsClient.Partition {
+		return fmt.Errorf("destination.0.bucket.0.bucket_arn: bucket %s is in partition %s, inventory reports can only be delivered to a bucket in partition %s", v.Resource, v.Partition, awsClient.Partition)
+	}
+
+	return nil
+}
+
+// inventoryDestinationPolicyWarnings returns warnings if the destination bucket policy doesn't allow Amazon S3 to deliver the inventory reports.
+// The policy of a bucket owned by another account can't usually be read, so the check is only advisory.
+func inventoryDestinationPolicyWarnings(ctx context.Context, awsClient *conns.AWSClient, bucketARN string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := arn.Parse(bucketARN)
+
+	if err != nil || v.Service != "s3" {
+		return diags
+	}
+
+	policy, err := findBucketPolicy(ctx, awsClient.S3Client(ctx), v.Resource)
+
+	switch {
+	case tfresource.NotFound(err):
+		diags = sdkdiag.AppendWarningf(diags, "S3 Bucket Inventory destination bucket (%s) has no bucket policy, inventory reports won't be delivered unless a policy allowing %s to put objects is added", v.Resource, inventoryDeliveryServicePrincipal)
+	case err != nil:
+		log.Printf("[DEBUG] Unable to read S3 Bucket Inventory destination bucket (%s) policy: %s", v.Resource, err)
+	case !bucketPolicyAllowsInventoryDelivery(policy):
+		diags = sdkdiag.AppendWarningf(diags, "S3 Bucket Inventory destination bucket (%s) policy does not allow %s to put objects, inventory reports won't be delivered", v.Resource, inventoryDeliveryServicePrincipal)
+	}
+
+	return diags
+}
+
+const (
+	inventoryDeliveryServicePrincipal = "s3.amazonaws.com"
+)
+
+// bucketPolicyAllowsInventoryDelivery returns whether the bucket policy has an Allow statement for the Amazon S3 service principal
+// that permits s3:PutObject.
+func bucketPolicyAllowsInventoryDelivery(policy string) bool {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false
+	}
+
+	type statement struct {
+		Action    interface{}
+		Effect    string
+		Principal interface{}
+	}
+	var statements []statement
+
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var v statement
+		if err := json.Unmarshal(doc.Statement, &v); err != nil {
+			return false
+		}
+		statements = []statement{v}
+	}
+
+	for _, v := range statements {
+		if v.Effect != "Allow" {
+			continue
+		}
+
+		principal, ok := v.Principal.(map[string]interface{})
+
+		if !ok || !slices.Contains(policyStringOrSlice(principal["Service"]), inventoryDeliveryServicePrincipal) {
+			continue
+		}
+
+		if slices.ContainsFunc(policyStringOrSlice(v.Action), func(action string) bool {
+			return action == "s3:*" || action == "*" || strings.EqualFold(action, "s3:PutObject")
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyStringOrSlice returns an IAM policy element that is either a single string or a list of strings as a slice.
+func policyStringOrSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
 func expandInventoryFilter(m map[string]interface{}) *types.InventoryFilter {
 	v, ok := m[names.AttrPrefix]
 	if !ok {
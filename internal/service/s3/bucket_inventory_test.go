@@ -66,6 +66,108 @@ func TestAccS3BucketInventory_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketInventory_optionalFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf types.InventoryConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_inventory.test"
+	inventoryName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketInventoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketInventoryConfig_optionalFields(rName, inventoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketInventoryExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "optional_fields.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectAccessControlList"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectOwner"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketInventory_destinationPartitionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	inventoryName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketInventoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketInventoryConfig_destinationPartition(rName, inventoryName),
+				ExpectError: regexache.MustCompile(`inventory reports can only be delivered to a bucket in partition`),
+			},
+		},
+	})
+}
+
+func TestBucketPolicyAllowsInventoryDelivery(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected bool
+	}{
+		"invalid JSON": {
+			policy:   `{`,
+			expected: false,
+		},
+		"no statements": {
+			policy:   `{"Version":"2012-10-17","Statement":[]}`,
+			expected: false,
+		},
+		"single statement": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::destination/*"}}`,
+			expected: true,
+		},
+		"statement list": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::destination/*"},{"Effect":"Allow","Principal":{"Service":["s3.amazonaws.com"]},"Action":["s3:PutObject"],"Resource":"arn:aws:s3:::destination/*"}]}`,
+			expected: true,
+		},
+		"wildcard action": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:*","Resource":"arn:aws:s3:::destination/*"}]}`,
+			expected: true,
+		},
+		"deny": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::destination/*"}]}`,
+			expected: false,
+		},
+		"other service principal": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"logging.s3.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::destination/*"}]}`,
+			expected: false,
+		},
+		"other action": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::destination/*"}]}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.BucketPolicyAllowsInventoryDelivery(testCase.policy), testCase.expected; got != want {
+				t.Errorf("BucketPolicyAllowsInventoryDelivery(%q) = %t, want %t", testCase.policy, got, want)
+			}
+		})
+	}
+}
+
 func TestAccS3BucketInventory_encryptWithSSES3(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.InventoryConfiguration
@@ -345,3 +447,58 @@ resource "aws_s3_bucket_inventory" "test" {
 }
 `, inventoryName))
 }
+
+func testAccBucketInventoryConfig_optionalFields(bucketName, inventoryName string) string {
+	return acctest.ConfigCompose(testAccBucketInventoryConfig_base(bucketName), fmt.Sprintf(`
+resource "aws_s3_bucket_inventory" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  included_object_versions = "Current"
+
+  optional_fields = [
+    "ObjectAccessControlList",
+    "ObjectOwner",
+  ]
+
+  schedule {
+    frequency = "Daily"
+  }
+
+  destination {
+    bucket {
+      format     = "CSV"
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`, inventoryName))
+}
+
+func testAccBucketInventoryConfig_destinationPartition(bucketName, inventoryName string) string {
+	return acctest.ConfigCompose(testAccBucketInventoryConfig_base(bucketName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+locals {
+  other_partition = data.aws_partition.current.partition == "aws" ? "aws-cn" : "aws"
+}
+
+resource "aws_s3_bucket_inventory" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  included_object_versions = "Current"
+
+  schedule {
+    frequency = "Daily"
+  }
+
+  destination {
+    bucket {
+      format     = "CSV"
+      bucket_arn = "arn:${local.other_partition}:s3:::%[2]s"
+    }
+  }
+}
+`, inventoryName, bucketName))
+}
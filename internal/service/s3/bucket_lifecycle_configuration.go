@@ -13,8 +13,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -252,6 +254,12 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"transition_default_minimum_object_size": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(transitionDefaultMinimumObjectSize_Values(), false),
+			},
 		},
 	}
 }
@@ -261,6 +269,9 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -273,8 +284,13 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	var optFns []func(*s3.Options)
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		optFns = append(optFns, withTransitionDefaultMinimumObjectSize(v.(string)))
+	}
+
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
-		return conn.PutBucketLifecycleConfiguration(ctx, input)
+		return conn.PutBucketLifecycleConfiguration(ctx, input, optFns...)
 	}, errCodeNoSuchBucket)
 
 	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
@@ -305,18 +321,23 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	const (
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
 	)
-	var lastOutput, output []types.LifecycleRule
+	var lastOutput []types.LifecycleRule
+	var output *s3.GetBucketLifecycleConfigurationOutput
 
 	err = retry.RetryContext(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
 		var err error
 
 		time.Sleep(lifecycleConfigurationExtraRetryDelay)
 
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
 		if d.IsNewResource() && tfresource.NotFound(err) {
 			return retry.RetryableError(err)
@@ -326,8 +347,8 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 			return retry.NonRetryableError(err)
 		}
 
-		if lastOutput == nil || !lifecycleRulesEqual(lastOutput, output) {
-			lastOutput = output
+		if lastOutput == nil || !lifecycleRulesEqual(lastOutput, output.Rules) {
+			lastOutput = output.Rules
 			return retry.RetryableError(fmt.Errorf("S3 Bucket Lifecycle Configuration (%s) has not stablized; retrying", d.Id()))
 		}
 
@@ -335,7 +356,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -350,9 +371,10 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
-	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output)); err != nil {
+	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output.Rules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
+	d.Set("transition_default_minimum_object_size", transitionDefaultMinimumObjectSize(output))
 
 	return diags
 }
@@ -366,6 +388,10 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -377,8 +403,13 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	var optFns []func(*s3.Options)
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		optFns = append(optFns, withTransitionDefaultMinimumObjectSize(v.(string)))
+	}
+
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
-		return conn.PutBucketLifecycleConfiguration(ctx, input)
+		return conn.PutBucketLifecycleConfiguration(ctx, input, optFns...)
 	}, errCodeNoSuchLifecycleConfiguration)
 
 	if err != nil {
@@ -403,6 +434,10 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.DeleteBucketLifecycleInput{
 		Bucket: aws.String(bucket),
 	}
@@ -455,6 +490,16 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 }
 
 func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.LifecycleRule, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Rules, nil
+}
+
+func findBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	input := &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -479,7 +524,7 @@ func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Rules, nil
+	return output, nil
 }
 
 func lifecycleRulesEqual(rules1, rules2 []types.LifecycleRule) bool {
@@ -546,6 +591,40 @@ func lifecycleRuleStatus_Values() []string {
 	}
 }
 
+const (
+	transitionDefaultMinimumObjectSizeAllStorageClasses128K = "all_storage_classes_128K"
+	transitionDefaultMinimumObjectSizeVariesByStorageClass  = "varies_by_storage_class"
+)
+
+func transitionDefaultMinimumObjectSize_Values() []string {
+	return []string{
+		transitionDefaultMinimumObjectSizeAllStorageClasses128K,
+		transitionDefaultMinimumObjectSizeVariesByStorageClass,
+	}
+}
+
+// The x-amz-transition-default-minimum-object-size request and response header
+// is not yet modeled by the AWS SDK for Go v2 S3 client.
+const headerTransitionDefaultMinimumObjectSize = "x-amz-transition-default-minimum-object-size"
+
+// withTransitionDefaultMinimumObjectSize sets the bucket's default minimum object size for transitions
+// on a PutBucketLifecycleConfiguration request.
+func withTransitionDefaultMinimumObjectSize(v string) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, smithyhttp.SetHeaderValue(headerTransitionDefaultMinimumObjectSize, v))
+	}
+}
+
+// transitionDefaultMinimumObjectSize returns the bucket's default minimum object size for transitions
+// from a GetBucketLifecycleConfiguration response.
+func transitionDefaultMinimumObjectSize(output *s3.GetBucketLifecycleConfigurationOutput) string {
+	if v, ok := awsmiddleware.GetRawResponse(output.ResultMetadata).(*smithyhttp.Response); ok {
+		return v.Header.Get(headerTransitionDefaultMinimumObjectSize)
+	}
+
+	return ""
+}
+
 func expandLifecycleRules(ctx context.Context, l []interface{}) []types.LifecycleRule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -960,8 +1039,8 @@ func flattenLifecycleRuleFilterMemberAnd(ctx context.Context, andOp *types.Lifec
 	}
 
 	m := map[string]interface{}{
-		"object_size_greater_than": andOp.Value.ObjectSizeGreaterThan,
-		"object_size_less_than":    andOp.Value.ObjectSizeLessThan,
+		"object_size_greater_than": aws.ToInt64(andOp.Value.ObjectSizeGreaterThan),
+		"object_size_less_than":    aws.ToInt64(andOp.Value.ObjectSizeLessThan),
 	}
 
 	if v := andOp.Value.Prefix; v != nil {
@@ -215,8 +215,8 @@ func resourceBucketLoggingRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("target_grant", flattenTargetGrants(loggingEnabled.TargetGrants)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting target_grant: %s", err)
 	}
-	if loggingEnabled.TargetObjectKeyFormat != nil {
-		if err := d.Set("target_object_key_format", []interface{}{flattenTargetObjectKeyFormat(loggingEnabled.TargetObjectKeyFormat)}); err != nil {
+	if v := loggingEnabled.TargetObjectKeyFormat; v != nil && !targetObjectKeyFormatIsDefault(v, d) {
+		if err := d.Set("target_object_key_format", []interface{}{flattenTargetObjectKeyFormat(v)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting target_object_key_format: %s", err)
 		}
 	} else {
@@ -432,6 +432,16 @@ func flattenLoggingGrantee(g *types.Grantee) []interface{} {
 	return []interface{}{m}
 }
 
+// targetObjectKeyFormatIsDefault returns whether the log object key format is the default simple format and isn't explicitly configured.
+// This avoids a diff when migrating a configuration that doesn't specify target_object_key_format, or that removes it.
+func targetObjectKeyFormatIsDefault(apiObject *types.TargetObjectKeyFormat, d *schema.ResourceData) bool {
+	if apiObject.PartitionedPrefix != nil {
+		return false
+	}
+
+	return d.Get("target_object_key_format.0.simple_prefix.#").(int) == 0
+}
+
 func expandTargetObjectKeyFormat(tfMap map[string]interface{}) *types.TargetObjectKeyFormat {
 	if tfMap == nil {
 		return nil
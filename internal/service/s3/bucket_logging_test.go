@@ -445,6 +445,62 @@ func TestAccS3BucketLogging_withTargetObjectKeyFormat(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLogging_TargetObjectKeyFormat_migrateFromSimplePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLoggingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLoggingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccBucketLoggingConfig_withTargetObjectKeyFormatSimplePrefix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccBucketLoggingConfig_withTargetObjectKeyFormatPartitionedPrefix(rName, "EventTime"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.0.partition_date_source", "EventTime"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccBucketLoggingConfig_withTargetObjectKeyFormatSimplePrefix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccBucketLoggingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLogging_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
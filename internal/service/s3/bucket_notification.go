@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -36,6 +37,8 @@ func resourceBucketNotification() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketNotificationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:     schema.TypeString,
@@ -387,6 +390,145 @@ func resourceBucketNotificationDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func resourceBucketNotificationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var rules []notificationRule
+
+	for _, k := range []string{"lambda_function", "queue", "topic"} {
+		for i, tfMapRaw := range d.Get(k).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := fmt.Sprintf("%s.%d", k, i)
+
+			if !d.NewValueKnown(key+".events") || !d.NewValueKnown(key+".filter_prefix") || !d.NewValueKnown(key+".filter_suffix") {
+				continue
+			}
+
+			rules = append(rules, notificationRule{
+				name:   key,
+				events: flex.ExpandStringyValueSet[types.Event](tfMap["events"].(*schema.Set)),
+				prefix: tfMap["filter_prefix"].(string),
+				suffix: tfMap["filter_suffix"].(string),
+			})
+		}
+	}
+
+	for i, a := range rules {
+		for _, b := range rules[i+1:] {
+			if notificationRulesOverlap(a, b) {
+				return fmt.Errorf("%s and %s overlap: notification configurations with a common event type must have non-overlapping filter prefixes or suffixes", a.name, b.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// notificationRule is the part of a bucket notification configuration that determines whether it overlaps with another.
+type notificationRule struct {
+	name   string
+	events []types.Event
+	prefix string
+	suffix string
+}
+
+// notificationRulesOverlap returns whether two notification configurations can't both be set on a bucket.
+// Configurations overlap if they have a common event type and both their filter prefixes and filter suffixes overlap.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-how-to-filtering.html.
+func notificationRulesOverlap(a, b notificationRule) bool {
+	if !slices.ContainsFunc(a.events, func(x types.Event) bool {
+		return slices.ContainsFunc(b.events, func(y types.Event) bool {
+			return notificationEventsOverlap(x, y)
+		})
+	}) {
+		return false
+	}
+
+	return (strings.HasPrefix(a.prefix, b.prefix) || strings.HasPrefix(b.prefix, a.prefix)) &&
+		(strings.HasSuffix(a.suffix, b.suffix) || strings.HasSuffix(b.suffix, a.suffix))
+}
+
+// notificationEventsOverlap returns whether two event types are the same, taking wildcards such as "s3:ObjectCreated:*" into account.
+func notificationEventsOverlap(a, b types.Event) bool {
+	if a == b {
+		return true
+	}
+
+	if v, ok := strings.CutSuffix(string(a), "*"); ok && strings.HasPrefix(string(b), v) {
+		return true
+	}
+
+	if v, ok := strings.CutSuffix(string(b), "*"); ok && strings.HasPrefix(string(a), v) {
+		return true
+	}
+
+	return false
+}
+
+// notificationRuleFromFilter returns the notificationRule for a notification configuration.
+func notificationRuleFromFilter(name string, events []types.Event, filter *types.NotificationConfigurationFilter) notificationRule {
+	rule := notificationRule{
+		name:   name,
+		events: events,
+	}
+
+	if filter != nil && filter.Key != nil {
+		for _, v := range filter.Key.FilterRules {
+			switch types.FilterRuleName(strings.ToLower(string(v.Name))) {
+			case types.FilterRuleNamePrefix:
+				rule.prefix = aws.ToString(v.Value)
+			case types.FilterRuleNameSuffix:
+				rule.suffix = aws.ToString(v.Value)
+			}
+		}
+	}
+
+	return rule
+}
+
+// modifyBucketNotificationConfiguration updates part of a bucket's notification configuration, leaving the rest unchanged.
+// Concurrent modifications of the same bucket's notification configuration are serialized.
+func modifyBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket string, f func(*types.NotificationConfiguration) error) error {
+	key := "s3-bucket-notification-" + bucket
+	conns.GlobalMutexKV.Lock(key)
+	defer conns.GlobalMutexKV.Unlock(key)
+
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return err
+	}
+
+	notificationConfiguration := &types.NotificationConfiguration{
+		EventBridgeConfiguration:     output.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}
+
+	if err := f(notificationConfiguration); err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: notificationConfiguration,
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketNotificationConfiguration(ctx, input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "NotificationConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
 func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketNotificationConfigurationOutput, error) {
 	input := &s3.GetBucketNotificationConfigurationInput{
 		Bucket: aws.String(bucket),
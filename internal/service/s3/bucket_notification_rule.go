@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_notification_rule", name="Bucket Notification Rule")
+func resourceBucketNotificationRule() *schema.Resource {
+	destinationKeys := []string{"lambda_function_arn", "queue_arn", names.AttrTopicARN}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketNotificationRuleCreate,
+		ReadWithoutTimeout:   resourceBucketNotificationRuleRead,
+		UpdateWithoutTimeout: resourceBucketNotificationRuleUpdate,
+		DeleteWithoutTimeout: resourceBucketNotificationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.Event](),
+				},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lambda_function_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: destinationKeys,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"queue_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: destinationKeys,
+			},
+			names.AttrTopicARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: destinationKeys,
+			},
+		},
+	}
+}
+
+func resourceBucketNotificationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, name := d.Get(names.AttrBucket).(string), d.Get(names.AttrName).(string)
+	id := BucketNotificationRuleCreateResourceID(bucket, name)
+
+	_, err := tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return nil, modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+			if _, err := findNotificationRuleByName(notificationConfiguration, name); err == nil {
+				return fmt.Errorf("notification configuration %s already exists", name)
+			}
+
+			return putNotificationRule(notificationConfiguration, d)
+		})
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Notification Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketNotificationRule(ctx, conn, bucket, name)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Notification Rule (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationRuleRead(ctx, d, meta)...)
+}
+
+func resourceBucketNotificationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, name, err := BucketNotificationRuleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule, err := findBucketNotificationRule(ctx, conn, bucket, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set("events", rule.events)
+	d.Set("filter_prefix", rule.prefix)
+	d.Set("filter_suffix", rule.suffix)
+	d.Set("lambda_function_arn", rule.lambdaFunctionARN)
+	d.Set(names.AttrName, name)
+	d.Set("queue_arn", rule.queueARN)
+	d.Set(names.AttrTopicARN, rule.topicARN)
+
+	return diags
+}
+
+func resourceBucketNotificationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, name, err := BucketNotificationRuleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+		removeNotificationRule(notificationConfiguration, name)
+
+		return putNotificationRule(notificationConfiguration, d)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationRuleRead(ctx, d, meta)...)
+}
+
+func resourceBucketNotificationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, name, err := BucketNotificationRuleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification Rule: %s", d.Id())
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+		removeNotificationRule(notificationConfiguration, name)
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketNotificationRule(ctx, conn, bucket, name)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Notification Rule (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const bucketNotificationRuleResourceIDSeparator = ":"
+
+func BucketNotificationRuleCreateResourceID(bucket, name string) string {
+	parts := []string{bucket, name}
+	id := strings.Join(parts, bucketNotificationRuleResourceIDSeparator)
+
+	return id
+}
+
+func BucketNotificationRuleParseResourceID(id string) (string, string, error) {
+	bucket, name, found := strings.Cut(id, bucketNotificationRuleResourceIDSeparator)
+
+	if found && bucket != "" && name != "" {
+		return bucket, name, nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bucket%[2]sname", id, bucketNotificationRuleResourceIDSeparator)
+}
+
+// bucketNotificationRule is a single Lambda function, SQS queue or SNS topic notification configuration.
+type bucketNotificationRule struct {
+	notificationRule
+	lambdaFunctionARN string
+	queueARN          string
+	topicARN          string
+}
+
+func findBucketNotificationRule(ctx context.Context, conn *s3.Client, bucket, name string) (*bucketNotificationRule, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findNotificationRuleByName(&types.NotificationConfiguration{
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}, name)
+}
+
+func findNotificationRuleByName(notificationConfiguration *types.NotificationConfiguration, name string) (*bucketNotificationRule, error) {
+	for _, rule := range notificationRules(notificationConfiguration) {
+		if rule.name == name {
+			return &rule, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(name)
+}
+
+// notificationRules returns all the Lambda function, SQS queue and SNS topic notification configurations.
+func notificationRules(notificationConfiguration *types.NotificationConfiguration) []bucketNotificationRule {
+	var rules []bucketNotificationRule
+
+	for _, v := range notificationConfiguration.LambdaFunctionConfigurations {
+		rules = append(rules, bucketNotificationRule{
+			notificationRule:  notificationRuleFromFilter(aws.ToString(v.Id), v.Events, v.Filter),
+			lambdaFunctionARN: aws.ToString(v.LambdaFunctionArn),
+		})
+	}
+
+	for _, v := range notificationConfiguration.QueueConfigurations {
+		rules = append(rules, bucketNotificationRule{
+			notificationRule: notificationRuleFromFilter(aws.ToString(v.Id), v.Events, v.Filter),
+			queueARN:         aws.ToString(v.QueueArn),
+		})
+	}
+
+	for _, v := range notificationConfiguration.TopicConfigurations {
+		rules = append(rules, bucketNotificationRule{
+			notificationRule: notificationRuleFromFilter(aws.ToString(v.Id), v.Events, v.Filter),
+			topicARN:         aws.ToString(v.TopicArn),
+		})
+	}
+
+	return rules
+}
+
+// putNotificationRule adds the configured notification rule to the bucket's notification configuration.
+// An error is returned if the rule overlaps with any of the bucket's other notification configurations.
+func putNotificationRule(notificationConfiguration *types.NotificationConfiguration, d *schema.ResourceData) error {
+	name := d.Get(names.AttrName).(string)
+	events := flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set))
+	rule := notificationRule{
+		name:   name,
+		events: events,
+		prefix: d.Get("filter_prefix").(string),
+		suffix: d.Get("filter_suffix").(string),
+	}
+
+	for _, v := range notificationRules(notificationConfiguration) {
+		if notificationRulesOverlap(rule, v.notificationRule) {
+			return fmt.Errorf("overlaps with notification configuration %s: notification configurations with a common event type must have non-overlapping filter prefixes or suffixes", v.name)
+		}
+	}
+
+	var filter *types.NotificationConfigurationFilter
+	var filterRules []types.FilterRule
+	if rule.prefix != "" {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNamePrefix,
+			Value: aws.String(rule.prefix),
+		})
+	}
+	if rule.suffix != "" {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNameSuffix,
+			Value: aws.String(rule.suffix),
+		})
+	}
+	if len(filterRules) > 0 {
+		filter = &types.NotificationConfigurationFilter{
+			Key: &types.S3KeyFilter{
+				FilterRules: filterRules,
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("lambda_function_arn"); ok {
+		notificationConfiguration.LambdaFunctionConfigurations = append(notificationConfiguration.LambdaFunctionConfigurations, types.LambdaFunctionConfiguration{
+			Events:            events,
+			Filter:            filter,
+			Id:                aws.String(name),
+			LambdaFunctionArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("queue_arn"); ok {
+		notificationConfiguration.QueueConfigurations = append(notificationConfiguration.QueueConfigurations, types.QueueConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       aws.String(name),
+			QueueArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk(names.AttrTopicARN); ok {
+		notificationConfiguration.TopicConfigurations = append(notificationConfiguration.TopicConfigurations, types.TopicConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       aws.String(name),
+			TopicArn: aws.String(v.(string)),
+		})
+	}
+
+	return nil
+}
+
+// removeNotificationRule removes the named notification rule from the bucket's notification configuration.
+func removeNotificationRule(notificationConfiguration *types.NotificationConfiguration, name string) {
+	notificationConfiguration.LambdaFunctionConfigurations = slices.DeleteFunc(notificationConfiguration.LambdaFunctionConfigurations, func(v types.LambdaFunctionConfiguration) bool {
+		return aws.ToString(v.Id) == name
+	})
+	notificationConfiguration.QueueConfigurations = slices.DeleteFunc(notificationConfiguration.QueueConfigurations, func(v types.QueueConfiguration) bool {
+		return aws.ToString(v.Id) == name
+	})
+	notificationConfiguration.TopicConfigurations = slices.DeleteFunc(notificationConfiguration.TopicConfigurations, func(v types.TopicConfiguration) bool {
+		return aws.ToString(v.Id) == name
+	})
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_topic(rName, "images/", ".jpg"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".jpg"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "queue_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_topic(rName, "images/", ".jpg"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_topic(rName, "images/", ".jpg"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".jpg"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationRuleConfig_topic(rName, "documents/", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "documents/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_withEventBridge(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_s3_bucket_notification_rule.test1"
+	resource2Name := "aws_s3_bucket_notification_rule.test2"
+	eventBridgeResourceName := "aws_s3_bucket_eventbridge_notification.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckBucketNotificationRuleDestroy(ctx),
+			testAccCheckBucketEventBridgeNotificationDestroy(ctx),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_withEventBridge(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(ctx, resource1Name),
+					testAccCheckBucketNotificationRuleExists(ctx, resource2Name),
+					testAccCheckBucketEventBridgeNotificationExists(ctx, eventBridgeResourceName),
+					resource.TestCheckResourceAttrPair(resource1Name, "queue_arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resource2Name, names.AttrTopicARN, "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_overlapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationRuleConfig_overlapping(rName),
+				ExpectError: regexache.MustCompile(`overlaps with notification configuration`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_notification_rule" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketNotificationRule(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationRuleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindBucketNotificationRule(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccBucketNotificationRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": { "Service": "s3.${data.aws_partition.current.dns_suffix}" },
+      "Action": "SNS:Publish",
+      "Resource": "arn:${data.aws_partition.current.partition}:sns:*:*:%[1]s",
+      "Condition": {
+        "ArnLike": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccBucketNotificationRuleConfig_topic(rName, prefix, suffix string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_rule" "test" {
+  bucket    = aws_s3_bucket.test.bucket
+  name      = %[1]q
+  topic_arn = aws_sns_topic.test.arn
+  events    = ["s3:ObjectCreated:*"]
+
+  filter_prefix = %[2]q
+  filter_suffix = %[3]q
+}
+`, rName, prefix, suffix))
+}
+
+func testAccBucketNotificationRuleConfig_withEventBridge(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket_eventbridge_notification" "test" {
+  bucket = aws_s3_bucket.test.bucket
+}
+
+resource "aws_s3_bucket_notification_rule" "test1" {
+  bucket    = aws_s3_bucket.test.bucket
+  name      = "%[1]s-1"
+  queue_arn = aws_sqs_queue.test.arn
+  events    = ["s3:ObjectCreated:*"]
+
+  filter_prefix = "uploads/"
+}
+
+resource "aws_s3_bucket_notification_rule" "test2" {
+  bucket    = aws_s3_bucket.test.bucket
+  name      = "%[1]s-2"
+  topic_arn = aws_sns_topic.test.arn
+  events    = ["s3:ObjectRemoved:*"]
+}
+`, rName))
+}
+
+func testAccBucketNotificationRuleConfig_overlapping(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_rule" "test1" {
+  bucket    = aws_s3_bucket.test.bucket
+  name      = "%[1]s-1"
+  topic_arn = aws_sns_topic.test.arn
+  events    = ["s3:ObjectCreated:*"]
+
+  filter_prefix = "images/"
+}
+
+resource "aws_s3_bucket_notification_rule" "test2" {
+  bucket    = aws_s3_bucket.test.bucket
+  name      = "%[1]s-2"
+  topic_arn = aws_sns_topic.test.arn
+  events    = ["s3:ObjectCreated:Put"]
+
+  filter_prefix = "images/thumbnails/"
+
+  depends_on = [aws_s3_bucket_notification_rule.test1]
+}
+`, rName))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -270,6 +271,101 @@ func TestAccS3BucketNotification_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_overlappingFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationConfig_overlappingFilters(rName),
+				ExpectError: regexache.MustCompile(`topic\.0 and topic\.1 overlap`),
+			},
+		},
+	})
+}
+
+func TestNotificationRulesOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		eventsA, eventsB []types.Event
+		prefixA, prefixB string
+		suffixA, suffixB string
+		expected         bool
+	}{
+		"no filters": {
+			eventsA:  []types.Event{types.EventS3ObjectCreatedPut},
+			eventsB:  []types.Event{types.EventS3ObjectCreatedPut},
+			expected: true,
+		},
+		"different events": {
+			eventsA: []types.Event{types.EventS3ObjectCreatedPut},
+			eventsB: []types.Event{types.EventS3ObjectRemovedDelete},
+		},
+		"wildcard event": {
+			eventsA:  []types.Event{types.EventS3ObjectCreated},
+			eventsB:  []types.Event{types.EventS3ObjectCreatedCopy},
+			expected: true,
+		},
+		"nested prefixes": {
+			eventsA:  []types.Event{types.EventS3ObjectCreated},
+			eventsB:  []types.Event{types.EventS3ObjectCreated},
+			prefixA:  "images/",
+			prefixB:  "images/thumbnails/",
+			expected: true,
+		},
+		"distinct prefixes": {
+			eventsA: []types.Event{types.EventS3ObjectCreated},
+			eventsB: []types.Event{types.EventS3ObjectCreated},
+			prefixA: "images/",
+			prefixB: "logs/",
+		},
+		"distinct suffixes": {
+			eventsA: []types.Event{types.EventS3ObjectCreated},
+			eventsB: []types.Event{types.EventS3ObjectCreated},
+			suffixA: ".jpg",
+			suffixB: ".png",
+		},
+		"nested suffixes": {
+			eventsA:  []types.Event{types.EventS3ObjectCreated},
+			eventsB:  []types.Event{types.EventS3ObjectCreated},
+			prefixA:  "images/",
+			suffixA:  ".jpg",
+			suffixB:  "thumbnail.jpg",
+			expected: true,
+		},
+		"prefix and distinct suffix": {
+			eventsA: []types.Event{types.EventS3ObjectCreated},
+			eventsB: []types.Event{types.EventS3ObjectCreated},
+			prefixA: "images/",
+			suffixB: ".log",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.NotificationRulesOverlap(testCase.eventsA, testCase.prefixA, testCase.suffixA, testCase.eventsB, testCase.prefixB, testCase.suffixB)
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+
+			got = tfs3.NotificationRulesOverlap(testCase.eventsB, testCase.prefixB, testCase.suffixB, testCase.eventsA, testCase.prefixA, testCase.suffixA)
+
+			if got != testCase.expected {
+				t.Errorf("reversed: got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccCheckBucketNotificationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
@@ -448,6 +544,36 @@ resource "aws_s3_bucket_notification" "test" {
 `, rName)
 }
 
+func testAccBucketNotificationConfig_overlappingFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  topic {
+    id            = "notification-sns1"
+    topic_arn     = aws_sns_topic.test.arn
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "images/"
+  }
+
+  topic {
+    id            = "notification-sns2"
+    topic_arn     = aws_sns_topic.test.arn
+    events        = ["s3:ObjectCreated:Put"]
+    filter_prefix = "images/thumbnails/"
+  }
+}
+`, rName)
+}
+
 func testAccBucketNotificationConfig_queue(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
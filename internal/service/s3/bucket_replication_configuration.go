@@ -5,19 +5,28 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	s3controltypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -36,7 +45,59 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"batch_replication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_replication_statuses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[s3controltypes.ReplicationStatus](),
+							},
+						},
+						"report": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucket: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrPrefix: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"report_scope": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          s3controltypes.JobReportScopeAllTasks,
+										ValidateDiagFunc: enum.Validate[s3controltypes.JobReportScope](),
+									},
+								},
+							},
+						},
+						names.AttrRole: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"batch_replication_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrBucket: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -365,6 +426,16 @@ func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Replication Configuration (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		jobID, err := createBatchReplicationJob(ctx, meta.(*conns.AWSClient), bucket, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Replication Configuration (%s) batch replication job: %s", d.Id(), err)
+		}
+
+		d.Set("batch_replication_job_id", jobID)
+	}
+
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -415,6 +486,17 @@ func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
 	}
 
+	// Existing objects are replicated when rules are added.
+	if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && hasNewReplicationRules(d) {
+		jobID, err := createBatchReplicationJob(ctx, meta.(*conns.AWSClient), d.Id(), v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Replication Configuration (%s): creating batch replication job: %s", d.Id(), err)
+		}
+
+		d.Set("batch_replication_job_id", jobID)
+	}
+
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -446,6 +528,127 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	return diags
 }
 
+func resourceBucketReplicationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Replication Time Control (RTC) requires replication metrics.
+	for i, v := range d.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		destination, ok := tfMap[names.AttrDestination].([]interface{})
+		if !ok || len(destination) == 0 || destination[0] == nil {
+			continue
+		}
+
+		tfMap = destination[0].(map[string]interface{})
+
+		if rtc := expandReplicationTime(tfMap["replication_time"].([]interface{})); rtc == nil || rtc.Status != types.ReplicationTimeStatusEnabled {
+			continue
+		}
+
+		if metrics := expandMetrics(tfMap["metrics"].([]interface{})); metrics == nil || metrics.Status != types.MetricsStatusEnabled || metrics.EventThreshold == nil {
+			return fmt.Errorf("rule.%d.destination: replication_time requires metrics with status %q and an event_threshold", i, types.MetricsStatusEnabled)
+		}
+	}
+
+	if d.Id() != "" && d.HasChange(names.AttrRule) && hasNewReplicationRules(d) {
+		if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 {
+			return d.SetNewComputed("batch_replication_job_id")
+		}
+	}
+
+	return nil
+}
+
+// hasNewReplicationRules returns whether any replication rule is being added.
+// Rules are identified by their ID, rules without an ID are always considered new.
+func hasNewReplicationRules(d sdkv2.ResourceDiffer) bool {
+	ruleIDs := func(v interface{}) []string {
+		var ids []string
+		for _, v := range v.([]interface{}) {
+			if tfMap, ok := v.(map[string]interface{}); ok {
+				ids = append(ids, tfMap[names.AttrID].(string))
+			}
+		}
+		return ids
+	}
+
+	o, n := d.GetChange(names.AttrRule)
+	oldIDs := ruleIDs(o)
+
+	for _, id := range ruleIDs(n) {
+		if id == "" || !slices.Contains(oldIDs, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// createBatchReplicationJob creates an S3 Batch Operations job that replicates the bucket's existing objects
+// that are eligible for replication.
+func createBatchReplicationJob(ctx context.Context, c *conns.AWSClient, bucket string, tfMap map[string]interface{}) (string, error) {
+	conn := c.S3ControlClient(ctx)
+
+	statuses := []s3controltypes.ReplicationStatus{s3controltypes.ReplicationStatusNone, s3controltypes.ReplicationStatusFailed}
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		statuses = flex.ExpandStringyValueSet[s3controltypes.ReplicationStatus](v)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(c.AccountID),
+		ClientRequestToken:   aws.String(sdkid.UniqueId()),
+		ConfirmationRequired: aws.Bool(false),
+		Description:          aws.String(fmt.Sprintf("Replicate existing objects in %s", bucket)),
+		ManifestGenerator: &s3controltypes.JobManifestGeneratorMemberS3JobManifestGenerator{
+			Value: s3controltypes.S3JobManifestGenerator{
+				EnableManifestOutput: false,
+				Filter: &s3controltypes.JobManifestGeneratorFilter{
+					EligibleForReplication:    aws.Bool(true),
+					ObjectReplicationStatuses: statuses,
+				},
+				SourceBucket: aws.String(arn.ARN{
+					Partition: c.Partition,
+					Service:   "s3",
+					Resource:  bucket,
+				}.String()),
+			},
+		},
+		Operation: &s3controltypes.JobOperation{
+			S3ReplicateObject: &s3controltypes.S3ReplicateObjectOperation{},
+		},
+		Priority: aws.Int32(10),
+		Report: &s3controltypes.JobReport{
+			Enabled: false,
+		},
+		RoleArn: aws.String(tfMap[names.AttrRole].(string)),
+	}
+
+	if v, ok := tfMap["report"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		input.Report = &s3controltypes.JobReport{
+			Bucket:      aws.String(tfMap[names.AttrBucket].(string)),
+			Enabled:     true,
+			Format:      s3controltypes.JobReportFormatReportCsv20180820,
+			ReportScope: s3controltypes.JobReportScope(tfMap["report_scope"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+			input.Report.Prefix = aws.String(strings.TrimSuffix(v, "/"))
+		}
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.JobId), nil
+}
+
 func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
 	input := &s3.GetBucketReplicationInput{
 		Bucket: aws.String(bucket),
@@ -503,6 +503,85 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_replicationTimeControlWithoutMetrics(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcNoMetrics(rName),
+				ExpectError: regexache.MustCompile(`replication_time requires metrics`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_batchReplication(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	var jobID string
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_batchReplication(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "batch_replication.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "batch_replication.0.role", "aws_iam_role.batch", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "batch_replication_job_id"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckResourceAttrWith(resourceName, "batch_replication_job_id", func(value string) error {
+						jobID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_batchReplication(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct2),
+					resource.TestCheckResourceAttrWith(resourceName, "batch_replication_job_id", func(value string) error {
+						if value == "" || value == jobID {
+							return fmt.Errorf("expected a new batch replication job, got %q", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"batch_replication", "batch_replication_job_id"},
+			},
+		},
+	})
+}
+
 func TestAccS3BucketReplicationConfiguration_replicaModifications(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1431,6 +1510,127 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`)
 }
 
+func testAccBucketReplicationConfigurationConfig_rtcNoMetrics(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Enabled"
+        time {
+          minutes = 15
+        }
+      }
+    }
+  }
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_batchReplication(rName string, secondRule bool) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "batch" {
+  name = "%[1]s-batch"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "batch" {
+  name = %[1]q
+  role = aws_iam_role.batch.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetReplicationConfiguration",
+        "s3:InitiateReplication",
+        "s3:PutInventoryConfiguration",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.source.arn,
+        "${aws_s3_bucket.source.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_iam_role_policy.batch,
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  batch_replication {
+    role = aws_iam_role.batch.arn
+  }
+
+  rule {
+    id       = "foo"
+    priority = 1
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+    }
+  }
+
+  dynamic "rule" {
+    for_each = %[2]t ? [1] : []
+
+    content {
+      id       = "bar"
+      priority = 2
+      filter {
+        prefix = "bar"
+      }
+      status = "Enabled"
+      delete_marker_replication {
+        status = "Enabled"
+      }
+      destination {
+        bucket = aws_s3_bucket.destination.arn
+      }
+    }
+  }
+}`, rName, secondRule))
+}
+
 func testAccBucketReplicationConfigurationConfig_replicaMods(rName string) string {
 	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
 resource "aws_s3_bucket_replication_configuration" "test" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -222,6 +223,37 @@ func (r *directoryBucketResource) Read(ctx context.Context, request resource.Rea
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *directoryBucketResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data directoryBucketResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Bucket.IsUnknown() || data.Location.IsUnknown() || data.Location.IsNull() {
+		return
+	}
+
+	locationInfoData, diags := data.Location.ToPtr(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() || locationInfoData == nil || locationInfoData.Name.IsUnknown() {
+		return
+	}
+
+	// The bucket name's zone ID must be that of the bucket's location.
+	if matches := directoryBucketNameRegex.FindStringSubmatch(data.Bucket.ValueString()); len(matches) == 3 && matches[2] != locationInfoData.Name.ValueString() {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrBucket),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("bucket name zone ID (%s) must match location name (%s)", matches[2], locationInfoData.Name.ValueString()),
+		)
+	}
+}
+
 func (r *directoryBucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	var old, new directoryBucketResourceModel
 
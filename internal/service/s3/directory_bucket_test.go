@@ -136,6 +136,24 @@ func testAccCheckDirectoryBucketExists(ctx context.Context, n string) resource.T
 	}
 }
 
+func TestAccS3DirectoryBucket_locationMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryBucketConfig_locationMismatch(rName),
+				ExpectError: regexache.MustCompile(`bucket name zone ID \(usw2-az1\) must match location name \(usw2-az2\)`),
+			},
+		},
+	})
+}
+
 func testAccConfigAvailableAZsDirectoryBucket() string {
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/s3-express-networking.html#s3-express-endpoints.
 	return acctest.ConfigAvailableAZsNoOptInExclude("use1-az1", "use1-az2", "use1-az3", "usw2-az2", "apne1-az2")
@@ -175,3 +193,15 @@ resource "aws_s3_directory_bucket" "test" {
 }
 `)
 }
+
+func testAccDirectoryBucketConfig_locationMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = "%[1]s--usw2-az1--x-s3"
+
+  location {
+    name = "usw2-az2"
+  }
+}
+`, rName)
+}
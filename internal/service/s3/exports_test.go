@@ -3,18 +3,24 @@
 
 package s3
 
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceBucketAccelerateConfiguration           = resourceBucketAccelerateConfiguration
 	ResourceBucketACL                               = resourceBucketACL
 	ResourceBucketAnalyticsConfiguration            = resourceBucketAnalyticsConfiguration
 	ResourceBucketCorsConfiguration                 = resourceBucketCorsConfiguration
+	ResourceBucketEventBridgeNotification           = resourceBucketEventBridgeNotification
 	ResourceBucketIntelligentTieringConfiguration   = resourceBucketIntelligentTieringConfiguration
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = resourceBucketLifecycleConfiguration
 	ResourceBucketLogging                           = resourceBucketLogging
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
+	ResourceBucketNotificationRule                  = resourceBucketNotificationRule
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
@@ -33,11 +39,14 @@ var (
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
 	EmptyBucket                           = emptyBucket
+	BucketPolicyAllowsInventoryDelivery   = bucketPolicyAllowsInventoryDelivery
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
 	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
+	FindBucketEventBridgeConfiguration    = findBucketEventBridgeConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
+	FindBucketNotificationRule            = findBucketNotificationRule
 	FindBucketPolicy                      = findBucketPolicy
 	FindBucketRequestPayment              = findBucketRequestPayment
 	FindBucketVersioning                  = findBucketVersioning
@@ -56,7 +65,10 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	NewSHA256VerifyingReader              = newSHA256VerifyingReader
 	ObjectListTags                        = objectListTags
+	ObjectUploadConfigurationHash         = objectUploadConfigurationHash
+	ObjectUploadPartSize                  = objectUploadPartSize
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
@@ -69,3 +81,10 @@ var (
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
 )
+
+func NotificationRulesOverlap(eventsA []types.Event, prefixA, suffixA string, eventsB []types.Event, prefixB, suffixB string) bool {
+	return notificationRulesOverlap(
+		notificationRule{events: eventsA, prefix: prefixA, suffix: suffixA},
+		notificationRule{events: eventsB, prefix: prefixB, suffix: suffixB},
+	)
+}
@@ -6,7 +6,11 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"log"
 	"net/http"
@@ -28,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -113,15 +118,20 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrContent: {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrSource, "content_base64"},
+				ConflictsWith: []string{names.AttrSource, "content_base64", "source_url"},
 			},
 			"content_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrSource, names.AttrContent},
+				ConflictsWith: []string{names.AttrSource, names.AttrContent, "source_url"},
 			},
 			"content_disposition": {
 				Type:     schema.TypeString,
@@ -149,6 +159,22 @@ func resourceObject() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{names.AttrKMSKeyID},
 			},
+			"failed_upload": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"upload_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -218,6 +244,16 @@ func resourceObject() *schema.Resource {
 					},
 				},
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
+			"resume_failed_upload": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"server_side_encryption": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -227,12 +263,25 @@ func resourceObject() *schema.Resource {
 			names.AttrSource: {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrContent, "content_base64"},
+				ConflictsWith: []string{names.AttrContent, "content_base64", "source_url"},
 			},
 			"source_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsURLWithHTTPS,
+				ConflictsWith: []string{names.AttrContent, "content_base64", names.AttrSource},
+				RequiredWith:  []string{"source_url_sha256"},
+			},
+			"source_url_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a hex-encoded SHA-256 digest"),
+				RequiredWith: []string{"source_url"},
+			},
 			names.AttrStorageClass: {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -325,7 +374,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if hasObjectContentChanges(d) {
+	if uploadID, _ := failedObjectUpload(d); hasObjectContentChanges(d) || uploadID != "" {
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
@@ -417,6 +466,12 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	key := sdkv1CompatibleCleanKey(d.Get(names.AttrKey).(string))
 
+	if uploadID, _ := failedObjectUpload(d); uploadID != "" {
+		if err := abortMultipartUpload(ctx, conn, bucket, key, uploadID, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+	}
+
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
 		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get(names.AttrForceDestroy).(bool), false, optFns...)
@@ -464,7 +519,8 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
 
-	var body io.ReadSeeker
+	var body io.Reader
+	var file *os.File
 
 	if v, ok := d.GetOk(names.AttrSource); ok {
 		source := v.(string)
@@ -472,7 +528,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
 		}
-		file, err := os.Open(path)
+		file, err = os.Open(path)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
 		}
@@ -484,6 +540,26 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
 			}
 		}()
+	} else if v, ok := d.GetOk("source_url"); ok {
+		sourceURL := v.(string)
+		response, err := openObjectSourceURL(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), sourceURL)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "opening S3 object source URL (%s): %s", sourceURL, err)
+		}
+
+		// The content is streamed to S3 and verified once it has been completely read.
+		// The upload fails without creating the object if the checksum doesn't match.
+		body, err = newSHA256VerifyingReader(response.Body, d.Get("source_url_sha256").(string))
+		if err != nil {
+			response.Body.Close()
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		defer func() {
+			err := response.Body.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source URL (%s): %s", sourceURL, err)
+			}
+		}()
 	} else if v, ok := d.GetOk(names.AttrContent); ok {
 		body = strings.NewReader(v.(string))
 	} else if v, ok := d.GetOk("content_base64"); ok {
@@ -588,10 +664,69 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	partSize, concurrency := int64(d.Get("part_size").(int)), d.Get("concurrency").(int)
+	if concurrency == 0 {
+		concurrency = manager.DefaultUploadConcurrency
+	}
+	// Failed multipart uploads are aborted, removing any uploaded parts, unless they are to be resumed.
+	// Only uploads from a local file can be resumed.
+	resume := d.Get("resume_failed_upload").(bool) && file != nil
+	configurationHash, err := objectUploadConfigurationHash(input, partSize)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var resumed bool
+	if uploadID, hash := failedObjectUpload(d); uploadID != "" {
+		// Keep the failed upload in state until it has been resumed or aborted.
+		d.Set("failed_upload", []interface{}{map[string]interface{}{
+			"configuration_hash": hash,
+			"upload_id":          uploadID,
+		}})
+
+		if resume && hash == configurationHash {
+			resumed, err = resumeObjectUpload(ctx, conn, input, uploadID, file, partSize, concurrency, optFns...)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+			}
+		}
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+		// A failed upload that can't be resumed with the current configuration is never completed.
+		if !resumed {
+			if err := abortMultipartUpload(ctx, conn, aws.ToString(input.Bucket), aws.ToString(input.Key), uploadID, optFns...); err != nil {
+				return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+			}
+		}
+
+		d.Set("failed_upload", nil)
+	}
+
+	if !resumed {
+		uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+			u.Concurrency = concurrency
+			u.LeavePartsOnError = resume
+			if partSize > 0 {
+				u.PartSize = partSize
+			}
+		})
+
+		if _, err := uploader.Upload(ctx, input); err != nil {
+			if v, ok := errs.As[manager.MultiUploadFailure](err); ok && resume {
+				// Record the failed upload so that only it is resumed by the next apply.
+				d.Set("failed_upload", []interface{}{map[string]interface{}{
+					"configuration_hash": configurationHash,
+					"upload_id":          v.UploadID(),
+				}})
+				if d.IsNewResource() {
+					d.SetId(d.Get(names.AttrKey).(string))
+				}
+
+				return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): parts of multipart upload (%s) are retained to be resumed: %s", aws.ToString(input.Key), aws.ToString(input.Bucket), v.UploadID(), err)
+			}
+
+			return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+		}
 	}
 
 	if d.IsNewResource() {
@@ -601,6 +736,59 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// openObjectSourceURL starts downloading an object's content from an HTTPS URL.
+func openObjectSourceURL(ctx context.Context, client *http.Client, sourceURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("unexpected HTTP status: %s", response.Status)
+	}
+
+	return response, nil
+}
+
+// sha256VerifyingReader returns an error instead of io.EOF if the content read doesn't have the expected SHA-256 digest.
+type sha256VerifyingReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected []byte
+}
+
+func newSHA256VerifyingReader(r io.Reader, expected string) (*sha256VerifyingReader, error) {
+	v, err := hex.DecodeString(expected)
+	if err != nil {
+		return nil, fmt.Errorf("decoding SHA-256 digest (%s): %w", expected, err)
+	}
+
+	return &sha256VerifyingReader{
+		reader:   r,
+		hash:     sha256.New(),
+		expected: v,
+	}, nil
+}
+
+func (r *sha256VerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.hash.Write(p[:n])
+
+	if errors.Is(err, io.EOF) {
+		if got := r.hash.Sum(nil); !bytes.Equal(got, r.expected) {
+			return n, fmt.Errorf("SHA-256 digest of source content (%s) does not match expected (%s)", hex.EncodeToString(got), hex.EncodeToString(r.expected))
+		}
+	}
+
+	return n, err
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -633,6 +821,15 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A failed upload recorded in state is resumed or replaced by the next apply.
+	if uploadID, _ := failedObjectUpload(d); uploadID != "" && d.Id() != "" {
+		if err := d.SetNewComputed("failed_upload"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("version_id")
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -645,6 +842,19 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// failedObjectUpload returns the ID and configuration hash of the failed multipart upload recorded in state.
+func failedObjectUpload(d sdkv2.ResourceDiffer) (string, string) {
+	o, _ := d.GetChange("failed_upload")
+	v, ok := o.([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return "", ""
+	}
+
+	tfMap := v[0].(map[string]interface{})
+
+	return tfMap["upload_id"].(string), tfMap["configuration_hash"].(string)
+}
+
 func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
@@ -662,6 +872,8 @@ func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 		"server_side_encryption",
 		names.AttrSource,
 		"source_hash",
+		"source_url",
+		"source_url_sha256",
 		names.AttrStorageClass,
 		"website_redirect",
 	} {
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// resumeObjectUpload completes the failed multipart upload with the specified ID from the source file.
+// Parts already uploaded are reused if their size and MD5 digest match the corresponding part of the file,
+// all other parts are (re-)uploaded.
+// ok is false if the upload no longer exists or was started with a different checksum algorithm or storage class.
+func resumeObjectUpload(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, uploadID string, file *os.File, partSize int64, concurrency int, optFns ...func(*s3.Options)) (bool, error) {
+	bucket, key := aws.ToString(input.Bucket), aws.ToString(input.Key)
+
+	upload, err := findMultipartUploadByThreePartKey(ctx, conn, bucket, key, uploadID, optFns...)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("reading multipart upload (%s) parts: %w", uploadID, err)
+	}
+
+	// Parts must all use the same checksum algorithm as the upload.
+	if upload.checksumAlgorithm != input.ChecksumAlgorithm {
+		return false, nil
+	}
+
+	if storageClass := input.StorageClass; upload.storageClass != storageClass && (storageClass != "" || upload.storageClass != types.StorageClassStandard) {
+		return false, nil
+	}
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return false, err
+	}
+
+	size := info.Size()
+	partSize = objectUploadPartSize(size, partSize)
+
+	var partNumbers []int32
+	for n, offset := int32(1), int64(0); offset < size || n == 1; n, offset = n+1, offset+partSize {
+		partNumbers = append(partNumbers, n)
+	}
+
+	completedParts, err := tfslices.ApplyToAllConcurrentlyWithError(partNumbers, concurrency, func(n int32) (types.CompletedPart, error) {
+		offset := int64(n-1) * partSize
+		section := io.NewSectionReader(file, offset, min(partSize, size-offset))
+
+		if part, ok := upload.parts[n]; ok {
+			reusable, err := partMatches(part, section)
+
+			if err != nil {
+				return types.CompletedPart{}, fmt.Errorf("reading part %d: %w", n, err)
+			}
+
+			if reusable {
+				return types.CompletedPart{
+					ChecksumCRC32:  part.ChecksumCRC32,
+					ChecksumCRC32C: part.ChecksumCRC32C,
+					ChecksumSHA1:   part.ChecksumSHA1,
+					ChecksumSHA256: part.ChecksumSHA256,
+					ETag:           part.ETag,
+					PartNumber:     aws.Int32(n),
+				}, nil
+			}
+
+			if _, err := section.Seek(0, io.SeekStart); err != nil {
+				return types.CompletedPart{}, fmt.Errorf("reading part %d: %w", n, err)
+			}
+		}
+
+		output, err := conn.UploadPart(ctx, &s3.UploadPartInput{
+			Body:              section,
+			Bucket:            aws.String(bucket),
+			ChecksumAlgorithm: input.ChecksumAlgorithm,
+			ContentLength:     aws.Int64(section.Size()),
+			Key:               aws.String(key),
+			PartNumber:        aws.Int32(n),
+			UploadId:          aws.String(uploadID),
+		}, optFns...)
+
+		if err != nil {
+			return types.CompletedPart{}, fmt.Errorf("uploading part %d: %w", n, err)
+		}
+
+		return types.CompletedPart{
+			ChecksumCRC32:  output.ChecksumCRC32,
+			ChecksumCRC32C: output.ChecksumCRC32C,
+			ChecksumSHA1:   output.ChecksumSHA1,
+			ChecksumSHA256: output.ChecksumSHA256,
+			ETag:           output.ETag,
+			PartNumber:     aws.Int32(n),
+		}, nil
+	})
+
+	if err != nil {
+		return true, fmt.Errorf("resuming multipart upload (%s): %w", uploadID, err)
+	}
+
+	_, err = conn.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+		UploadId: aws.String(uploadID),
+	}, optFns...)
+
+	if err != nil {
+		return true, fmt.Errorf("completing multipart upload (%s): %w", uploadID, err)
+	}
+
+	return true, nil
+}
+
+// objectUploadPartSize returns the part size used to upload an object of the specified size.
+// As with manager.Uploader, the part size is increased if the object would otherwise need more than the maximum number of parts.
+func objectUploadPartSize(size, partSize int64) int64 {
+	if partSize == 0 {
+		partSize = manager.DefaultUploadPartSize
+	}
+
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	return partSize
+}
+
+// partMatches returns whether the uploaded part has the same size and content as the part's data.
+// Parts encrypted with SSE-KMS or SSE-C never match as their ETags are not the MD5 digests of their data.
+func partMatches(part types.Part, r *io.SectionReader) (bool, error) {
+	if aws.ToInt64(part.Size) != r.Size() {
+		return false, nil
+	}
+
+	h := md5.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return false, err
+	}
+
+	return strings.Trim(aws.ToString(part.ETag), `"`) == hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// objectUploadConfigurationHash returns a digest of the settings a multipart upload is started with.
+// A failed upload is only resumed if it was started with the same settings.
+func objectUploadConfigurationHash(input *s3.PutObjectInput, partSize int64) (string, error) {
+	v := struct {
+		ACL                       types.ObjectCannedACL
+		BucketKeyEnabled          *bool
+		CacheControl              *string
+		ChecksumAlgorithm         types.ChecksumAlgorithm
+		ContentDisposition        *string
+		ContentEncoding           *string
+		ContentLanguage           *string
+		ContentType               *string
+		Metadata                  map[string]string
+		ObjectLockLegalHoldStatus types.ObjectLockLegalHoldStatus
+		ObjectLockMode            types.ObjectLockMode
+		ObjectLockRetainUntilDate *time.Time
+		PartSize                  int64
+		SSEKMSKeyID               *string
+		ServerSideEncryption      types.ServerSideEncryption
+		StorageClass              types.StorageClass
+		Tagging                   *string
+		WebsiteRedirectLocation   *string
+	}{
+		ACL:                       input.ACL,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		PartSize:                  partSize,
+		SSEKMSKeyID:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
+func abortMultipartUpload(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) error {
+	_, err := conn.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchUpload) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("aborting multipart upload (%s): %w", uploadID, err)
+	}
+
+	return nil
+}
+
+type multipartUpload struct {
+	checksumAlgorithm types.ChecksumAlgorithm
+	parts             map[int32]types.Part
+	storageClass      types.StorageClass
+}
+
+func findMultipartUploadByThreePartKey(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) (*multipartUpload, error) {
+	input := &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+	output := &multipartUpload{
+		parts: make(map[int32]types.Part),
+	}
+
+	pages := s3.NewListPartsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchUpload) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.checksumAlgorithm = page.ChecksumAlgorithm
+		output.storageClass = page.StorageClass
+
+		for _, v := range page.Parts {
+			output.parts[aws.ToInt32(v.PartNumber)] = v
+		}
+	}
+
+	return output, nil
+}
@@ -4,13 +4,17 @@
 package s3_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +111,142 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestObjectUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		mib = 1024 * 1024
+		gib = 1024 * mib
+	)
+
+	testCases := []struct {
+		name     string
+		size     int64
+		partSize int64
+		want     int64
+	}{
+		{
+			name: "default part size",
+			size: 100 * mib,
+			want: 5 * mib,
+		},
+		{
+			name:     "configured part size",
+			size:     100 * mib,
+			partSize: 16 * mib,
+			want:     16 * mib,
+		},
+		{
+			name:     "too many parts",
+			size:     100 * gib,
+			partSize: 5 * mib,
+			want:     100*gib/10000 + 1,
+		},
+		{
+			name:     "maximum parts",
+			size:     10000*5*mib - 1,
+			partSize: 5 * mib,
+			want:     5 * mib,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectUploadPartSize(testCase.size, testCase.partSize), testCase.want; got != want {
+				t.Errorf("ObjectUploadPartSize(%d, %d) = %d, want %d", testCase.size, testCase.partSize, got, want)
+			}
+		})
+	}
+}
+
+func TestObjectUploadConfigurationHash(t *testing.T) {
+	t.Parallel()
+
+	base := func() *s3.PutObjectInput {
+		return &s3.PutObjectInput{
+			Bucket:            aws.String("test-bucket"),
+			ChecksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+			ContentType:       aws.String("text/plain"),
+			Key:               aws.String("test-key"),
+			Metadata:          map[string]string{"a": "1", "b": "2"},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(*s3.PutObjectInput)
+		partSize int64
+		same     bool
+	}{
+		{
+			name:   "unchanged",
+			modify: func(*s3.PutObjectInput) {},
+			same:   true,
+		},
+		{
+			name:   "body ignored",
+			modify: func(input *s3.PutObjectInput) { input.Body = strings.NewReader("content") },
+			same:   true,
+		},
+		{
+			name:   "content type",
+			modify: func(input *s3.PutObjectInput) { input.ContentType = aws.String("application/json") },
+		},
+		{
+			name:   "metadata",
+			modify: func(input *s3.PutObjectInput) { input.Metadata["b"] = "3" },
+		},
+		{
+			name:   "KMS key",
+			modify: func(input *s3.PutObjectInput) { input.SSEKMSKeyId = aws.String("alias/test") },
+		},
+		{
+			name:   "storage class",
+			modify: func(input *s3.PutObjectInput) { input.StorageClass = types.StorageClassStandardIa },
+		},
+		{
+			name:   "tags",
+			modify: func(input *s3.PutObjectInput) { input.Tagging = aws.String("k=v") },
+		},
+		{
+			name:   "object lock",
+			modify: func(input *s3.PutObjectInput) { input.ObjectLockMode = types.ObjectLockModeGovernance },
+		},
+		{
+			name:     "part size",
+			modify:   func(*s3.PutObjectInput) {},
+			partSize: 16 * 1024 * 1024,
+		},
+	}
+
+	want, err := tfs3.ObjectUploadConfigurationHash(base(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := base()
+			testCase.modify(input)
+
+			got, err := tfs3.ObjectUploadConfigurationHash(input, testCase.partSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if same := got == want; same != testCase.same {
+				t.Errorf("ObjectUploadConfigurationHash() same = %t, want %t", same, testCase.same)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -281,6 +421,112 @@ func TestAccS3Object_source(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_sourceURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceURL(rName, "initial content", "sha256(local.content)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "initial content"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_sourceURL(rName, "updated content", "sha256(local.content)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "updated content"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "source_url", "source_url_sha256"},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceURLChecksumMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_sourceURL(rName, "some content", `sha256("other content")`),
+				ExpectError: regexache.MustCompile(`SHA-256 digest of source content .* does not match expected`),
+			},
+		},
+	})
+}
+
+func TestSHA256VerifyingReader(t *testing.T) {
+	t.Parallel()
+
+	const content = "some content"
+	sum := sha256.Sum256([]byte(content))
+
+	testCases := map[string]struct {
+		expected    string
+		expectError bool
+	}{
+		"match": {
+			expected: hex.EncodeToString(sum[:]),
+		},
+		"match upper case": {
+			expected: strings.ToUpper(hex.EncodeToString(sum[:])),
+		},
+		"mismatch": {
+			expected:    strings.Repeat("0", 64),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := tfs3.NewSHA256VerifyingReader(strings.NewReader(content), testCase.expected)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := io.ReadAll(r)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != content {
+				t.Errorf("got %q, expected %q", got, content)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_content(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1619,6 +1865,82 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// 3 parts of 5 MiB, 5 MiB and 2 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, "SHA256", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", acctest.Ct2),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "part_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "resume_failed_upload", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_sha256", "concurrency", names.AttrForceDestroy, "part_size", "resume_failed_upload", names.AttrSource},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_MultipartUpload_resumeIgnoresUntrackedUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	data := strings.Repeat("0123456789abcdef", 12*1024*1024/16)
+	source := testAccObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_basic(rName),
+			},
+			{
+				// An incomplete upload of the same key by another writer must not be completed.
+				PreConfig: func() {
+					testAccStartObjectMultipartUpload(ctx, t, rName, "test-key", []byte(data[:5*1024*1024]))
+				},
+				Config: testAccObjectConfig_multipartUpload(rName, source, "CRC32C", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectIncompleteMultipartUploads(ctx, rName, "test-key", 1),
+					resource.TestMatchResourceAttr(resourceName, "checksum_crc32c", regexache.MustCompile(`-3$`)),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "failed_upload.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "resume_failed_upload", acctest.CtTrue),
+					testAccAbortObjectMultipartUploads(ctx, rName, "test-key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2068,6 +2390,85 @@ func testAccCheckObjectSSE(ctx context.Context, n, want string) resource.TestChe
 	}
 }
 
+func testAccStartObjectMultipartUpload(ctx context.Context, t *testing.T, bucket, key string, part []byte) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+	output, err := conn.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+		Key:               aws.String(key),
+	})
+
+	if err != nil {
+		t.Fatalf("creating S3 multipart upload: %s", err)
+	}
+
+	_, err = conn.UploadPart(ctx, &s3.UploadPartInput{
+		Body:              bytes.NewReader(part),
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+		Key:               aws.String(key),
+		PartNumber:        aws.Int32(1),
+		UploadId:          output.UploadId,
+	})
+
+	if err != nil {
+		t.Fatalf("uploading S3 multipart upload part: %s", err)
+	}
+}
+
+func testAccCheckObjectIncompleteMultipartUploads(ctx context.Context, bucket, key string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := conn.ListMultipartUploads(ctx, &s3.ListMultipartUploadsInput{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.Uploads); got != want {
+			return fmt.Errorf("S3 Object (%s/%s) has %d incomplete multipart uploads, want %d", bucket, key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAbortObjectMultipartUploads(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := conn.ListMultipartUploads(ctx, &s3.ListMultipartUploadsInput{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Uploads {
+			_, err := conn.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucket),
+				Key:      v.Key,
+				UploadId: v.UploadId,
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccObjectCreateTempFile(t *testing.T, data string) string {
 	tmpFile, err := os.CreateTemp("", "tf-acc-s3-obj")
 	if err != nil {
@@ -2235,6 +2636,62 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_sourceURL(rName, content, checksum string) string {
+	return fmt.Sprintf(`
+locals {
+  content = %[2]q
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_bucket_public_access_block" "source" {
+  bucket = aws_s3_bucket.source.id
+
+  block_public_acls       = false
+  block_public_policy     = false
+  ignore_public_acls      = false
+  restrict_public_buckets = false
+}
+
+resource "aws_s3_bucket_policy" "source" {
+  bucket = aws_s3_bucket.source.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "s3:GetObject"
+      Resource  = "${aws_s3_bucket.source.arn}/*"
+    }]
+  })
+
+  depends_on = [aws_s3_bucket_public_access_block.source]
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "source-key"
+  content = local.content
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+
+  source_url        = "https://${aws_s3_bucket.source.bucket_regional_domain_name}/${aws_s3_object.source.key}"
+  source_url_sha256 = %[3]s
+
+  depends_on = [aws_s3_bucket_policy.source]
+}
+`, rName, content, checksum)
+}
+
 func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -2908,6 +3365,25 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source, checksumAlgorithm string, resume bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+
+  checksum_algorithm   = %[3]q
+  concurrency          = 2
+  part_size            = 5242880
+  resume_failed_upload = %[4]t
+}
+`, rName, source, checksumAlgorithm, resume)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
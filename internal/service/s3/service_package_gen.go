@@ -14,6 +14,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newBucketIntelligentTieringConfigurationsDataSource,
+			Name:    "Bucket Intelligent-Tiering Configurations",
+		},
 		{
 			Factory: newDirectoryBucketsDataSource,
 			Name:    "Directory Buckets",
@@ -109,6 +113,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_cors_configuration",
 			Name:     "Bucket CORS Configuration",
 		},
+		{
+			Factory:  resourceBucketEventBridgeNotification,
+			TypeName: "aws_s3_bucket_eventbridge_notification",
+			Name:     "Bucket EventBridge Notification",
+		},
 		{
 			Factory:  resourceBucketIntelligentTieringConfiguration,
 			TypeName: "aws_s3_bucket_intelligent_tiering_configuration",
@@ -139,6 +148,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_notification",
 			Name:     "Bucket Notification",
 		},
+		{
+			Factory:  resourceBucketNotificationRule,
+			TypeName: "aws_s3_bucket_notification_rule",
+			Name:     "Bucket Notification Rule",
+		},
 		{
 			Factory:  resourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Access Grant")
+func newAccessGrantDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &accessGrantDataSource{}
+
+	return d, nil
+}
+
+type accessGrantDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *accessGrantDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3control_access_grant"
+}
+
+func (d *accessGrantDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_grant_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_grant_id": schema.StringAttribute{
+				Required: true,
+			},
+			"access_grants_location_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[accessGrantsLocationConfigurationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[accessGrantsLocationConfigurationModel](ctx),
+			},
+			"access_grants_location_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"application_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"grant_scope": schema.StringAttribute{
+				Computed: true,
+			},
+			"grantee": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[granteeModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[granteeModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"permission": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *accessGrantDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AccountID.ValueString(), data.AccessGrantID.ValueString()}, accessGrantResourceIDPartCount, false)))
+
+	output, err := findAccessGrantByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.AccessGrantID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if isNullAccessGrantsLocationConfiguration(output.AccessGrantsLocationConfiguration) {
+		output.AccessGrantsLocationConfiguration = nil
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, data.AccessGrantARN.ValueString(), data.AccountID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Access Grant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type accessGrantDataSourceModel struct {
+	AccessGrantARN                    types.String                                                            `tfsdk:"access_grant_arn"`
+	AccessGrantID                     types.String                                                            `tfsdk:"access_grant_id"`
+	AccessGrantsLocationConfiguration fwtypes.ListNestedObjectValueOf[accessGrantsLocationConfigurationModel] `tfsdk:"access_grants_location_configuration"`
+	AccessGrantsLocationID            types.String                                                            `tfsdk:"access_grants_location_id"`
+	AccountID                         types.String                                                            `tfsdk:"account_id"`
+	ApplicationARN                    types.String                                                            `tfsdk:"application_arn"`
+	CreatedAt                         timetypes.RFC3339                                                       `tfsdk:"created_at"`
+	Grantee                           fwtypes.ListNestedObjectValueOf[granteeModel]                           `tfsdk:"grantee"`
+	GrantScope                        types.String                                                            `tfsdk:"grant_scope"`
+	ID                                types.String                                                            `tfsdk:"id"`
+	Permission                        fwtypes.StringEnum[awstypes.Permission]                                 `tfsdk:"permission"`
+	Tags                              types.Map                                                               `tfsdk:"tags"`
+}
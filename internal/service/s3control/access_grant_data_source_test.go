@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccessGrantDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_access_grant.test"
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grant_arn", resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grant_id", resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants_location_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_location_configuration.0.s3_sub_prefix", resourceName, "access_grants_location_configuration.0.s3_sub_prefix"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_location_id", resourceName, "access_grants_location_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grant_scope", resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(dataSourceName, "grantee.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grantee.0.grantee_identifier", resourceName, "grantee.0.grantee_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grantee.0.grantee_type", resourceName, "grantee.0.grantee_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permission", resourceName, "permission"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_locationConfiguration(rName), `
+data "aws_s3control_access_grant" "test" {
+  access_grant_id = aws_s3control_access_grant.test.access_grant_id
+}
+`)
+}
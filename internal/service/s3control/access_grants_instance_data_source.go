@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Access Grants Instance")
+func newAccessGrantsInstanceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &accessGrantsInstanceDataSource{}
+
+	return d, nil
+}
+
+type accessGrantsInstanceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *accessGrantsInstanceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3control_access_grants_instance"
+}
+
+func (d *accessGrantsInstanceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_grants_instance_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_grants_instance_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"identity_center_application_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *accessGrantsInstanceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantsInstanceDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+
+	output, err := findAccessGrantsInstance(ctx, conn, data.AccountID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
+	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.ID = data.AccountID
+	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+
+	tags, err := listTags(ctx, conn, data.AccessGrantsInstanceARN.ValueString(), data.AccountID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Access Grants Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Tags = flex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type accessGrantsInstanceDataSourceModel struct {
+	AccessGrantsInstanceARN      types.String      `tfsdk:"access_grants_instance_arn"`
+	AccessGrantsInstanceID       types.String      `tfsdk:"access_grants_instance_id"`
+	AccountID                    types.String      `tfsdk:"account_id"`
+	CreatedAt                    timetypes.RFC3339 `tfsdk:"created_at"`
+	ID                           types.String      `tfsdk:"id"`
+	IdentityCenterApplicationARN types.String      `tfsdk:"identity_center_application_arn"`
+	Tags                         types.Map         `tfsdk:"tags"`
+}
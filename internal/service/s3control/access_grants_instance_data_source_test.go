@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccessGrantsInstanceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_s3control_access_grants_instance.test"
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_instance_arn", resourceName, "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_instance_id", resourceName, "access_grants_instance_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedAt),
+					resource.TestCheckNoResourceAttr(dataSourceName, "identity_center_application_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstanceDataSource_identityCenter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_s3control_access_grants_instance.test"
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceDataSourceConfig_identityCenter(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_instance_arn", resourceName, "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_center_application_arn", resourceName, "identity_center_application_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstanceDataSourceConfig_basic() string {
+	return `
+resource "aws_s3control_access_grants_instance" "test" {
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_s3control_access_grants_instance" "test" {
+  account_id = aws_s3control_access_grants_instance.test.account_id
+}
+`
+}
+
+func testAccAccessGrantsInstanceDataSourceConfig_identityCenter() string {
+	return acctest.ConfigCompose(testAccAccessGrantsInstanceConfig_identityCenter(), `
+data "aws_s3control_access_grants_instance" "test" {
+  account_id = aws_s3control_access_grants_instance.test.account_id
+}
+`)
+}
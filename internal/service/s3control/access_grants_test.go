@@ -31,6 +31,16 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			"tags":                  testAccAccessGrant_tags,
 			"locationConfiguration": testAccAccessGrant_locationConfiguration,
 		},
+		"InstanceDataSource": {
+			acctest.CtBasic:  testAccAccessGrantsInstanceDataSource_basic,
+			"identityCenter": testAccAccessGrantsInstanceDataSource_identityCenter,
+		},
+		"LocationDataSource": {
+			acctest.CtBasic: testAccAccessGrantsLocationDataSource_basic,
+		},
+		"GrantDataSource": {
+			acctest.CtBasic: testAccAccessGrantDataSource_basic,
+		},
 		"InstanceResourcePolicy": {
 			acctest.CtBasic:      testAccAccessGrantsInstanceResourcePolicy_basic,
 			acctest.CtDisappears: testAccAccessGrantsInstanceResourcePolicy_disappears,
@@ -0,0 +1,2182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3batch_operations_job", name="Batch Operations Job")
+// @Tags
+func resourceBatchOperationsJob() *schema.Resource {
+	s3TagSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrKey: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1024),
+					},
+					names.AttrValue: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(0, 1024),
+					},
+				},
+			},
+		}
+	}
+	s3GrantSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grantee": {
+						Type:     schema.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								names.AttrDisplayName: {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+								names.AttrIdentifier: {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+								"type_identifier": {
+									Type:             schema.TypeString,
+									Optional:         true,
+									ForceNew:         true,
+									ValidateDiagFunc: enum.Validate[types.S3GranteeTypeIdentifier](),
+								},
+							},
+						},
+					},
+					"permission": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ForceNew:         true,
+						ValidateDiagFunc: enum.Validate[types.S3Permission](),
+					},
+				},
+			},
+		}
+	}
+
+	jobOperationKeys := tfslices.ApplyToAll([]string{
+		"lambda_invoke",
+		"s3_delete_object_tagging",
+		"s3_initiate_restore_object",
+		"s3_put_object_acl",
+		"s3_put_object_copy",
+		"s3_put_object_legal_hold",
+		"s3_put_object_retention",
+		"s3_put_object_tagging",
+		"s3_replicate_object",
+	}, func(v string) string {
+		return "operation.0." + v
+	})
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchOperationsJobCreate,
+		ReadWithoutTimeout:   resourceBatchOperationsJobRead,
+		UpdateWithoutTimeout: resourceBatchOperationsJobUpdate,
+		DeleteWithoutTimeout: resourceBatchOperationsJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrLocation: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+									names.AttrFormat: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_job_manifest_generator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_manifest_output": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrExpectedBucketOwner: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrFilter: {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"created_after": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"created_before": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"eligible_for_replication": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"key_name_constraint": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"match_any_prefix": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"match_any_substring": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"match_any_suffix": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"match_any_storage_class": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+													},
+												},
+												"object_replication_statuses": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.ReplicationStatus](),
+													},
+												},
+												"object_size_greater_than_bytes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than_bytes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"manifest_output_location": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucket: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"expected_manifest_bucket_owner": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"manifest_encryption": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"sse_kms": {
+																Type:         schema.TypeList,
+																Optional:     true,
+																ForceNew:     true,
+																MaxItems:     1,
+																ExactlyOneOf: []string{"manifest_generator.0.s3_job_manifest_generator.0.manifest_output_location.0.manifest_encryption.0.sse_kms", "manifest_generator.0.s3_job_manifest_generator.0.manifest_output_location.0.manifest_encryption.0.sse_s3"},
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		names.AttrKeyID: {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ForceNew:     true,
+																			ValidateFunc: verify.ValidARN,
+																		},
+																	},
+																},
+															},
+															"sse_s3": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	// No options currently; just existence of "sse_s3".
+																	Schema: map[string]*schema.Schema{},
+																},
+															},
+														},
+													},
+												},
+												"manifest_format": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.GeneratedManifestFormat](),
+												},
+												"manifest_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"source_bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFunctionARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_delete_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								// No options currently; just existence of "s3_delete_object_tagging".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_policy": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_control_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"grant": s3GrantSchema(),
+															names.AttrOwner: {
+																Type:     schema.TypeList,
+																Required: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		names.AttrDisplayName: {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		names.AttrID: {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"canned_access_control_list": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_grant": s3GrantSchema(),
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												names.AttrContentType: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"requester_charged": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3SSEAlgorithm](),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": s3TagSchema(),
+									"object_lock_legal_hold_status": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+									"object_lock_mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockMode](),
+									},
+									"object_lock_retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrStorageClass: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_legal_hold": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"legal_hold": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrStatus: {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"retention": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMode: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3ObjectLockRetentionMode](),
+												},
+												"retain_until_date": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": s3TagSchema(),
+								},
+							},
+						},
+						"s3_replicate_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								// No options currently; just existence of "s3_replicate_object".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			"requested_job_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestedJobStatus](),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBatchOperationsJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(sdkid.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:                 getTagsInS3(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = expandJobManifestGenerator(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// The IAM role may not yet be assumable by S3 Batch Operations.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJob(ctx, input)
+	}, errCodeInvalidRequest, "Unable to assume role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Batch Operations Job: %s", err)
+	}
+
+	jobID := aws.ToString(outputRaw.(*s3control.CreateJobOutput).JobId)
+	d.SetId(BatchOperationsJobCreateResourceID(accountID, jobID))
+
+	job, err := waitJobCreated(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("requested_job_status"); ok && job.Status == types.JobStatusSuspended {
+		if err := updateJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("wait_for_completion").(bool) && (!d.Get("confirmation_required").(bool) || d.Get("requested_job_status").(string) == string(types.RequestedJobStatusReady)) {
+		if _, err := waitJobComplete(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBatchOperationsJobRead(ctx, d, meta)...)
+}
+
+func resourceBatchOperationsJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAccountID, accountID)
+	d.Set(names.AttrARN, job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	if job.CreationTime != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(job.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationTime, nil)
+	}
+	d.Set(names.AttrDescription, job.Description)
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if v, ok := job.ManifestGenerator.(*types.JobManifestGeneratorMemberS3JobManifestGenerator); ok {
+		if err := d.Set("manifest_generator", []interface{}{flattenJobManifestGenerator(&v.Value)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting manifest_generator: %s", err)
+		}
+	} else {
+		d.Set("manifest_generator", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(job.Operation)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting operation: %s", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set(names.AttrPriority, job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting progress_summary: %s", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting report: %s", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set(names.AttrRoleARN, job.RoleArn)
+	d.Set(names.AttrStatus, job.Status)
+
+	tags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOutS3(ctx, tagsS3(tags))
+
+	return diags
+}
+
+func resourceBatchOperationsJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange(names.AttrPriority) {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  int32(d.Get(names.AttrPriority).(int)),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("requested_job_status") {
+		if v, ok := d.GetOk("requested_job_status"); ok {
+			if err := updateJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+			}
+
+			if v.(string) == string(types.RequestedJobStatusReady) && d.Get("wait_for_completion").(bool) {
+				if _, err := waitJobComplete(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) complete: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBatchOperationsJobRead(ctx, d, meta)...)
+}
+
+func resourceBatchOperationsJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Jobs can't be deleted, they're retained for 90 days after they finish.
+	// Cancel any job that hasn't yet finished.
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	switch job.Status {
+	case types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusFailed, types.JobStatusFailing:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	err = updateJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatusCancelled, "")
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) || errs.IsA[*types.JobStatusException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const batchOperationsJobResourceIDSeparator = ":"
+
+func BatchOperationsJobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, batchOperationsJobResourceIDSeparator)
+
+	return id
+}
+
+func BatchOperationsJobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, batchOperationsJobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, batchOperationsJobResourceIDSeparator)
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func updateJobStatus(ctx context.Context, conn *s3control.Client, accountID, jobID string, status types.RequestedJobStatus, reason string) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: status,
+	}
+
+	if reason != "" {
+		input.StatusUpdateReason = aws.String(reason)
+	}
+
+	_, err := conn.UpdateJobStatus(ctx, input)
+
+	return err
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJobCreated(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusNew, types.JobStatusPreparing),
+		Target: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCancelled,
+			types.JobStatusCancelling,
+			types.JobStatusComplete,
+			types.JobStatusCompleting,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Timeout:    timeout,
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobFailureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobComplete(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCompleting,
+			types.JobStatusNew,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+		),
+		Target:     enum.Slice(types.JobStatusComplete),
+		Timeout:    timeout,
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobFailureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func jobFailureReasonsError(apiObjects []types.JobFailure) error {
+	return errors.Join(tfslices.ApplyToAll(apiObjects, func(v types.JobFailure) error {
+		return fmt.Errorf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason))
+	})...)
+}
+
+func jobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsS3(ctx, output.Tags), nil
+}
+
+func jobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      tagsS3(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap[names.AttrLocation].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		location := &types.JobManifestLocation{}
+
+		if v, ok := tfMap["etag"].(string); ok && v != "" {
+			location.ETag = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+			location.ObjectArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+			location.ObjectVersionId = aws.String(v)
+		}
+
+		apiObject.Location = location
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		spec := &types.JobManifestSpec{}
+
+		if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+			spec.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+		}
+
+		if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+			spec.Format = types.JobManifestFormat(v)
+		}
+
+		apiObject.Spec = spec
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGenerator(tfMap map[string]interface{}) types.JobManifestGenerator {
+	if tfMap == nil {
+		return nil
+	}
+
+	v, ok := tfMap["s3_job_manifest_generator"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	apiObject := types.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = v
+	}
+
+	if v, ok := tfMap[names.AttrExpectedBucketOwner].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return &types.JobManifestGeneratorMemberS3JobManifestGenerator{
+		Value: apiObject,
+	}
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *types.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["key_name_constraint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		keyNameConstraint := &types.KeyNameConstraint{}
+
+		if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+			keyNameConstraint.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["match_any_substring"].(*schema.Set); ok && v.Len() > 0 {
+			keyNameConstraint.MatchAnySubstring = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+			keyNameConstraint.MatchAnySuffix = flex.ExpandStringValueSet(v)
+		}
+
+		apiObject.KeyNameConstraint = keyNameConstraint
+	}
+
+	if v, ok := tfMap["match_any_storage_class"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyStorageClass = flex.ExpandStringyValueSet[types.S3StorageClass](v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringyValueSet[types.ReplicationStatus](v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThanBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThanBytes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *types.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryption := &types.GeneratedManifestEncryption{}
+
+		if v, ok := tfMap["sse_kms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			encryption.SSEKMS = &types.SSEKMSEncryption{
+				KeyId: aws.String(v[0].(map[string]interface{})[names.AttrKeyID].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sse_s3"].([]interface{}); ok && len(v) > 0 {
+			encryption.SSES3 = &types.SSES3Encryption{}
+		}
+
+		apiObject.ManifestEncryption = encryption
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = types.GeneratedManifestFormat(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.LambdaInvokeOperation{}
+
+		if v, ok := tfMap[names.AttrFunctionARN].(string); ok && v != "" {
+			operation.FunctionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+			operation.InvocationSchemaVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+			operation.UserArguments = flex.ExpandStringValueMap(v)
+		}
+
+		apiObject.LambdaInvoke = operation
+	}
+
+	if v, ok := tfMap["s3_delete_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3DeleteObjectTagging = &types.S3DeleteObjectTaggingOperation{}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.S3InitiateRestoreObjectOperation{}
+
+		if v, ok := tfMap["expiration_in_days"].(int); ok && v > 0 {
+			operation.ExpirationInDays = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+			operation.GlacierJobTier = types.S3GlacierJobTier(v)
+		}
+
+		apiObject.S3InitiateRestoreObject = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.S3SetObjectAclOperation{}
+
+		if v, ok := tfMap["access_control_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			operation.AccessControlPolicy = expandS3AccessControlPolicy(v[0].(map[string]interface{}))
+		}
+
+		apiObject.S3PutObjectAcl = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.S3SetObjectLegalHoldOperation{}
+
+		if v, ok := tfMap["legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			operation.LegalHold = &types.S3ObjectLockLegalHold{
+				Status: types.S3ObjectLockLegalHoldStatus(v[0].(map[string]interface{})[names.AttrStatus].(string)),
+			}
+		}
+
+		apiObject.S3PutObjectLegalHold = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.S3SetObjectRetentionOperation{}
+
+		if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+			operation.BypassGovernanceRetention = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			retention := &types.S3Retention{}
+
+			if v, ok := tfMap[names.AttrMode].(string); ok && v != "" {
+				retention.Mode = types.S3ObjectLockRetentionMode(v)
+			}
+
+			if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+				v, _ := time.Parse(time.RFC3339, v)
+				retention.RetainUntilDate = aws.Time(v)
+			}
+
+			operation.Retention = retention
+		}
+
+		apiObject.S3PutObjectRetention = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &types.S3SetObjectTaggingOperation{}
+
+		if v, ok := tfMap["tag_set"].(*schema.Set); ok && v.Len() > 0 {
+			operation.TagSet = expandS3Tags(v.List())
+		}
+
+		apiObject.S3PutObjectTagging = operation
+	}
+
+	if v, ok := tfMap["s3_replicate_object"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3ReplicateObject = &types.S3ReplicateObjectOperation{}
+	}
+
+	return apiObject
+}
+
+func expandS3AccessControlPolicy(tfMap map[string]interface{}) *types.S3AccessControlPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3AccessControlPolicy{}
+
+	if v, ok := tfMap["access_control_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		acl := &types.S3AccessControlList{}
+
+		if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+			acl.Grants = expandS3Grants(v.List())
+		}
+
+		if v, ok := tfMap[names.AttrOwner].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			owner := &types.S3ObjectOwner{}
+
+			if v, ok := tfMap[names.AttrDisplayName].(string); ok && v != "" {
+				owner.DisplayName = aws.String(v)
+			}
+
+			if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+				owner.ID = aws.String(v)
+			}
+
+			acl.Owner = owner
+		}
+
+		apiObject.AccessControlList = acl
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["access_control_grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AccessControlGrants = expandS3Grants(v.List())
+	}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+		apiObject.BucketKeyEnabled = v
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NewObjectTagging = expandS3Tags(v.List())
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = types.S3ObjectLockLegalHoldStatus(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = types.S3ObjectLockMode(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok {
+		apiObject.RequesterPays = v
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStorageClass].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *types.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrContentType].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["requester_charged"].(bool); ok {
+		apiObject.RequesterCharged = v
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = types.S3SSEAlgorithm(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Grants(tfList []interface{}) []types.S3Grant {
+	var apiObjects []types.S3Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.S3Grant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			grantee := &types.S3Grantee{}
+
+			if v, ok := tfMap[names.AttrDisplayName].(string); ok && v != "" {
+				grantee.DisplayName = aws.String(v)
+			}
+
+			if v, ok := tfMap[names.AttrIdentifier].(string); ok && v != "" {
+				grantee.Identifier = aws.String(v)
+			}
+
+			if v, ok := tfMap["type_identifier"].(string); ok && v != "" {
+				grantee.TypeIdentifier = types.S3GranteeTypeIdentifier(v)
+			}
+
+			apiObject.Grantee = grantee
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = types.S3Permission(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Tags(tfList []interface{}) []types.S3Tag {
+	var apiObjects []types.S3Tag
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.S3Tag{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobManifest(apiObject *types.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap[names.AttrLocation] = []interface{}{map[string]interface{}{
+			"etag":              aws.ToString(v.ETag),
+			"object_arn":        aws.ToString(v.ObjectArn),
+			"object_version_id": aws.ToString(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields":         flex.FlattenStringyValueList(v.Fields),
+			names.AttrFormat: v.Format,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGenerator(apiObject *types.S3JobManifestGenerator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_manifest_output":      apiObject.EnableManifestOutput,
+		names.AttrExpectedBucketOwner: aws.ToString(apiObject.ExpectedBucketOwner),
+		"source_bucket":               aws.ToString(apiObject.SourceBucket),
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap[names.AttrFilter] = []interface{}{flattenJobManifestGeneratorFilter(v)}
+	}
+
+	if v := apiObject.ManifestOutputLocation; v != nil {
+		tfMap["manifest_output_location"] = []interface{}{flattenS3ManifestOutputLocation(v)}
+	}
+
+	return map[string]interface{}{
+		"s3_job_manifest_generator": []interface{}{tfMap},
+	}
+}
+
+func flattenJobManifestGeneratorFilter(apiObject *types.JobManifestGeneratorFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"eligible_for_replication":       aws.ToBool(apiObject.EligibleForReplication),
+		"match_any_storage_class":        flex.FlattenStringyValueSet(apiObject.MatchAnyStorageClass),
+		"object_replication_statuses":    flex.FlattenStringyValueSet(apiObject.ObjectReplicationStatuses),
+		"object_size_greater_than_bytes": aws.ToInt64(apiObject.ObjectSizeGreaterThanBytes),
+		"object_size_less_than_bytes":    aws.ToInt64(apiObject.ObjectSizeLessThanBytes),
+	}
+
+	if v := apiObject.CreatedAfter; v != nil {
+		tfMap["created_after"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CreatedBefore; v != nil {
+		tfMap["created_before"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.KeyNameConstraint; v != nil {
+		tfMap["key_name_constraint"] = []interface{}{map[string]interface{}{
+			"match_any_prefix":    flex.FlattenStringValueSet(v.MatchAnyPrefix),
+			"match_any_substring": flex.FlattenStringValueSet(v.MatchAnySubstring),
+			"match_any_suffix":    flex.FlattenStringValueSet(v.MatchAnySuffix),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3ManifestOutputLocation(apiObject *types.S3ManifestOutputLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrBucket:                 aws.ToString(apiObject.Bucket),
+		"expected_manifest_bucket_owner": aws.ToString(apiObject.ExpectedManifestBucketOwner),
+		"manifest_format":                apiObject.ManifestFormat,
+		"manifest_prefix":                aws.ToString(apiObject.ManifestPrefix),
+	}
+
+	if v := apiObject.ManifestEncryption; v != nil {
+		encryption := map[string]interface{}{}
+
+		if v.SSEKMS != nil {
+			encryption["sse_kms"] = []interface{}{map[string]interface{}{
+				names.AttrKeyID: aws.ToString(v.SSEKMS.KeyId),
+			}}
+		}
+
+		if v.SSES3 != nil {
+			encryption["sse_s3"] = []interface{}{map[string]interface{}{}}
+		}
+
+		tfMap["manifest_encryption"] = []interface{}{encryption}
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *types.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			names.AttrFunctionARN:       aws.ToString(v.FunctionArn),
+			"invocation_schema_version": aws.ToString(v.InvocationSchemaVersion),
+			"user_arguments":            v.UserArguments,
+		}}
+	}
+
+	if v := apiObject.S3DeleteObjectTagging; v != nil {
+		tfMap["s3_delete_object_tagging"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.ToInt32(v.ExpirationInDays),
+			"glacier_job_tier":   v.GlacierJobTier,
+		}}
+	}
+
+	if v := apiObject.S3PutObjectAcl; v != nil {
+		operation := map[string]interface{}{}
+
+		if v := v.AccessControlPolicy; v != nil {
+			operation["access_control_policy"] = []interface{}{flattenS3AccessControlPolicy(v)}
+		}
+
+		tfMap["s3_put_object_acl"] = []interface{}{operation}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectLegalHold; v != nil {
+		operation := map[string]interface{}{}
+
+		if v := v.LegalHold; v != nil {
+			operation["legal_hold"] = []interface{}{map[string]interface{}{
+				names.AttrStatus: v.Status,
+			}}
+		}
+
+		tfMap["s3_put_object_legal_hold"] = []interface{}{operation}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		operation := map[string]interface{}{
+			"bypass_governance_retention": aws.ToBool(v.BypassGovernanceRetention),
+		}
+
+		if v := v.Retention; v != nil {
+			retention := map[string]interface{}{
+				names.AttrMode: v.Mode,
+			}
+
+			if v := v.RetainUntilDate; v != nil {
+				retention["retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+			}
+
+			operation["retention"] = []interface{}{retention}
+		}
+
+		tfMap["s3_put_object_retention"] = []interface{}{operation}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": flattenS3Tags(v.TagSet),
+		}}
+	}
+
+	if v := apiObject.S3ReplicateObject; v != nil {
+		tfMap["s3_replicate_object"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenS3AccessControlPolicy(apiObject *types.S3AccessControlPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"canned_access_control_list": apiObject.CannedAccessControlList,
+	}
+
+	if v := apiObject.AccessControlList; v != nil {
+		acl := map[string]interface{}{
+			"grant": flattenS3Grants(v.Grants),
+		}
+
+		if v := v.Owner; v != nil {
+			acl[names.AttrOwner] = []interface{}{map[string]interface{}{
+				names.AttrDisplayName: aws.ToString(v.DisplayName),
+				names.AttrID:          aws.ToString(v.ID),
+			}}
+		}
+
+		tfMap["access_control_list"] = []interface{}{acl}
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(apiObject *types.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"access_control_grant":          flattenS3Grants(apiObject.AccessControlGrants),
+		"bucket_key_enabled":            apiObject.BucketKeyEnabled,
+		"canned_access_control_list":    apiObject.CannedAccessControlList,
+		"checksum_algorithm":            apiObject.ChecksumAlgorithm,
+		"metadata_directive":            apiObject.MetadataDirective,
+		"new_object_tagging":            flattenS3Tags(apiObject.NewObjectTagging),
+		"object_lock_legal_hold_status": apiObject.ObjectLockLegalHoldStatus,
+		"object_lock_mode":              apiObject.ObjectLockMode,
+		"requester_pays":                apiObject.RequesterPays,
+		"sse_aws_kms_key_id":            aws.ToString(apiObject.SSEAwsKmsKeyId),
+		names.AttrStorageClass:          apiObject.StorageClass,
+		"target_key_prefix":             aws.ToString(apiObject.TargetKeyPrefix),
+		"target_resource":               aws.ToString(apiObject.TargetResource),
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMap["new_object_metadata"] = []interface{}{map[string]interface{}{
+			"cache_control":       aws.ToString(v.CacheControl),
+			"content_disposition": aws.ToString(v.ContentDisposition),
+			"content_encoding":    aws.ToString(v.ContentEncoding),
+			"content_language":    aws.ToString(v.ContentLanguage),
+			names.AttrContentType: aws.ToString(v.ContentType),
+			"requester_charged":   v.RequesterCharged,
+			"sse_algorithm":       v.SSEAlgorithm,
+			"user_metadata":       v.UserMetadata,
+		}}
+	}
+
+	if v := apiObject.ObjectLockRetainUntilDate; v != nil {
+		tfMap["object_lock_retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenS3Grants(apiObjects []types.S3Grant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"permission": apiObject.Permission,
+		}
+
+		if v := apiObject.Grantee; v != nil {
+			tfMap["grantee"] = []interface{}{map[string]interface{}{
+				names.AttrDisplayName: aws.ToString(v.DisplayName),
+				names.AttrIdentifier:  aws.ToString(v.Identifier),
+				"type_identifier":     v.TypeIdentifier,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3Tags(apiObjects []types.S3Tag) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobProgressSummary(apiObject *types.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_tasks_failed":    aws.ToInt64(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.ToInt64(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.ToInt64(apiObject.TotalNumberOfTasks),
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *types.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrBucket:  aws.ToString(apiObject.Bucket),
+		names.AttrEnabled: apiObject.Enabled,
+		names.AttrFormat:  apiObject.Format,
+		names.AttrPrefix:  aws.ToString(apiObject.Prefix),
+		"report_scope":    apiObject.ReportScope,
+	}
+
+	return tfMap
+}
@@ -0,0 +1,611 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlBatchOperationsJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", "S3BatchOperations_CSV_20180820"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.fields.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "report.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusSuspended)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceBatchOperationsJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusSuspended)),
+				),
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_basic(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v2),
+					testAccCheckBatchOperationsJobNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "20"),
+				),
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_requestedJobStatus(rName, string(types.RequestedJobStatusCancelled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v2),
+					testAccCheckBatchOperationsJobNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "requested_job_status", string(types.RequestedJobStatusCancelled)),
+					resource.TestCheckResourceAttr(resourceName, "status_update_reason", "testing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_manifestGenerator(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_manifestGenerator(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.enable_manifest_output", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.filter.0.key_name_constraint.0.match_any_prefix.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.source_bucket", "aws_s3_bucket.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "operation.0.s3_put_object_copy.0.target_resource", "aws_s3_bucket.target", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.storage_class", "STANDARD_IA"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "report.0.report_scope", "FailedTasksOnly"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusComplete)),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBatchOperationsJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3batch_operations_job" {
+				continue
+			}
+
+			accountID, jobID, err := tfs3control.BatchOperationsJobParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Jobs can't be deleted, only cancelled.
+			switch output.Status {
+			case types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusFailed, types.JobStatusFailing:
+				continue
+			}
+
+			return fmt.Errorf("S3 Batch Operations Job %s still active", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchOperationsJobExists(ctx context.Context, n string, v *types.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountID, jobID, err := tfs3control.BatchOperationsJobParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBatchOperationsJobNotRecreated(before, after *types.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.JobId, *after.JobId; before != after {
+			return fmt.Errorf("S3 Batch Operations Job (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccBatchOperationsJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/test.txt"
+  content = "test"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_object.test.key}"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "batchoperations.s3.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetObjectTagging",
+        "s3:PutObject",
+        "s3:PutObjectTagging",
+        "s3:ListBucket",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBatchOperationsJobConfig_basic(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3batch_operations_job" "test" {
+  confirmation_required = true
+  description           = %[1]q
+  priority              = %[2]d
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set {
+        key   = "Name"
+        value = %[1]q
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, priority))
+}
+
+func testAccBatchOperationsJobConfig_requestedJobStatus(rName, status string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3batch_operations_job" "test" {
+  confirmation_required = true
+  description           = %[1]q
+  priority              = 20
+  requested_job_status  = %[2]q
+  role_arn              = aws_iam_role.test.arn
+  status_update_reason  = "testing"
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set {
+        key   = "Name"
+        value = %[1]q
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, status))
+}
+
+func testAccBatchOperationsJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3batch_operations_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccBatchOperationsJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3batch_operations_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccBatchOperationsJobConfig_manifestGenerator(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "source" {
+  count = 3
+
+  bucket  = aws_s3_bucket.source.bucket
+  key     = count.index < 2 ? "data/${count.index}.txt" : "other/${count.index}.txt"
+  content = "test"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket        = "%[1]s-target"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "batchoperations.s3.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetObjectTagging",
+        "s3:ListBucket",
+      ]
+      Resource = [
+        aws_s3_bucket.source.arn,
+        "${aws_s3_bucket.source.arn}/*",
+      ]
+    }, {
+      Effect = "Allow"
+      Action = [
+        "s3:PutObject",
+        "s3:PutObjectTagging",
+      ]
+      Resource = [
+        aws_s3_bucket.target.arn,
+        "${aws_s3_bucket.target.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_s3batch_operations_job" "test" {
+  priority            = 10
+  role_arn            = aws_iam_role.test.arn
+  wait_for_completion = true
+
+  manifest_generator {
+    s3_job_manifest_generator {
+      enable_manifest_output = false
+      source_bucket          = aws_s3_bucket.source.arn
+
+      filter {
+        key_name_constraint {
+          match_any_prefix = ["data/"]
+        }
+      }
+    }
+  }
+
+  operation {
+    s3_put_object_copy {
+      storage_class   = "STANDARD_IA"
+      target_resource = aws_s3_bucket.target.arn
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.target.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "FailedTasksOnly"
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.source]
+}
+`, rName)
+}
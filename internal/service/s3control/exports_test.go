@@ -12,14 +12,17 @@ var (
 	ResourceAccessPoint                        = resourceAccessPoint
 	ResourceAccessPointPolicy                  = resourceAccessPointPolicy
 	ResourceAccountPublicAccessBlock           = resourceAccountPublicAccessBlock
+	ResourceBatchOperationsJob                 = resourceBatchOperationsJob
 	ResourceBucket                             = resourceBucket
 	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
 	ResourceBucketPolicy                       = resourceBucketPolicy
 	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
 	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
+	ResourceMultiRegionAccessPointRoute        = resourceMultiRegionAccessPointRoute
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
 	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
 	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
+	ResourceStorageLensGroup                   = newStorageLensGroupResource
 
 	FindAccessGrantByTwoPartKey                            = findAccessGrantByTwoPartKey
 	FindAccessGrantsInstance                               = findAccessGrantsInstance
@@ -28,13 +31,16 @@ var (
 	FindAccessPointByTwoPartKey                            = findAccessPointByTwoPartKey
 	FindAccessPointPolicyAndStatusByTwoPartKey             = findAccessPointPolicyAndStatusByTwoPartKey
 	FindBucketByTwoPartKey                                 = findBucketByTwoPartKey
+	FindJobByTwoPartKey                                    = findJobByTwoPartKey
 	FindBucketLifecycleConfigurationByTwoPartKey           = findBucketLifecycleConfigurationByTwoPartKey
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
 	FindMultiRegionAccessPointByTwoPartKey                 = findMultiRegionAccessPointByTwoPartKey
 	FindMultiRegionAccessPointPolicyDocumentByTwoPartKey   = findMultiRegionAccessPointPolicyDocumentByTwoPartKey
+	FindMultiRegionAccessPointRoutesByTwoPartKey           = findMultiRegionAccessPointRoutesByTwoPartKey
 	FindObjectLambdaAccessPointAliasByTwoPartKey           = findObjectLambdaAccessPointAliasByTwoPartKey
 	FindObjectLambdaAccessPointConfigurationByTwoPartKey   = findObjectLambdaAccessPointConfigurationByTwoPartKey
 	FindObjectLambdaAccessPointPolicyAndStatusByTwoPartKey = findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey
 	FindPublicAccessBlockByAccountID                       = findPublicAccessBlockByAccountID
 	FindStorageLensConfigurationByAccountIDAndConfigID     = findStorageLensConfigurationByAccountIDAndConfigID
+	FindStorageLensGroupByTwoPartKey                       = findStorageLensGroupByTwoPartKey
 )
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	multiRegionAccessPointRouteTrafficDialActive  = 100
+	multiRegionAccessPointRouteTrafficDialPassive = 0
+)
+
+// @SDKResource("aws_s3control_multi_region_access_point_route", name="Multi-Region Access Point Route")
+func resourceMultiRegionAccessPointRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiRegionAccessPointRouteCreate,
+		ReadWithoutTimeout:   resourceMultiRegionAccessPointRouteRead,
+		UpdateWithoutTimeout: resourceMultiRegionAccessPointRouteUpdate,
+		DeleteWithoutTimeout: resourceMultiRegionAccessPointRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mrap": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"traffic_dial_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{multiRegionAccessPointRouteTrafficDialPassive, multiRegionAccessPointRouteTrafficDialActive}),
+						},
+					},
+				},
+				Set: multiRegionAccessPointRouteHash,
+			},
+		},
+	}
+}
+
+func resourceMultiRegionAccessPointRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	mrapARN := d.Get("mrap").(string)
+	routes := expandMultiRegionAccessPointRoutes(d.Get("route").(*schema.Set).List())
+
+	if err := submitMultiRegionAccessPointRoutes(ctx, conn, mrapARN, routes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Multi-Region Access Point Route (%s): %s", mrapARN, err)
+	}
+
+	d.SetId(mrapARN)
+
+	return append(diags, resourceMultiRegionAccessPointRouteRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionAccessPointRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, err := multiRegionAccessPointAccountID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Multi-Region Access Point Route (%s): %s", d.Id(), err)
+	}
+
+	// Only the routes for the configured Regions are managed.
+	// All routes are read on import.
+	routes := output
+	if v := d.Get("route").(*schema.Set); v.Len() > 0 {
+		regions := make(map[string]bool)
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				regions[tfMap[names.AttrRegion].(string)] = true
+			}
+		}
+
+		routes = nil
+		for _, route := range output {
+			if regions[aws.ToString(route.Region)] {
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	d.Set(names.AttrAccountID, accountID)
+	d.Set("mrap", d.Id())
+	if err := d.Set("route", flattenMultiRegionAccessPointRoutes(routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMultiRegionAccessPointRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	routes := expandMultiRegionAccessPointRoutes(d.Get("route").(*schema.Set).List())
+
+	if err := submitMultiRegionAccessPointRoutes(ctx, conn, d.Id(), routes, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Multi-Region Access Point Route (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMultiRegionAccessPointRouteRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionAccessPointRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// At least one Region must always be active, so the routing configuration is left unchanged.
+	log.Printf("[WARN] S3 Multi-Region Access Point Route (%s) deleted from state; routing configuration left unchanged", d.Id())
+
+	return diags
+}
+
+// multiRegionAccessPointAccountID returns the owning account ID from a Multi-Region Access Point ARN.
+func multiRegionAccessPointAccountID(mrapARN string) (string, error) {
+	v, err := arn.Parse(mrapARN)
+	if err != nil {
+		return "", err
+	}
+
+	return v.AccountID, nil
+}
+
+func submitMultiRegionAccessPointRoutes(ctx context.Context, conn *s3control.Client, mrapARN string, routes []types.MultiRegionAccessPointRoute, timeout time.Duration) error {
+	accountID, err := multiRegionAccessPointAccountID(mrapARN)
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.SubmitMultiRegionAccessPointRoutesInput{
+		AccountId:    aws.String(accountID),
+		Mrap:         aws.String(mrapARN),
+		RouteUpdates: routes,
+	}
+
+	_, err = conn.SubmitMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+		o.Region = names.USWest2RegionID
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := waitMultiRegionAccessPointRoutesApplied(ctx, conn, accountID, mrapARN, routes, timeout); err != nil {
+		return fmt.Errorf("waiting for routes to be applied: %w", err)
+	}
+
+	return nil
+}
+
+func findMultiRegionAccessPointRoutesByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, mrapARN string) ([]types.MultiRegionAccessPointRoute, error) {
+	input := &s3control.GetMultiRegionAccessPointRoutesInput{
+		AccountId: aws.String(accountID),
+		Mrap:      aws.String(mrapARN),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+		o.Region = names.USWest2RegionID
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Routes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Routes, nil
+}
+
+// waitMultiRegionAccessPointRoutesApplied waits for the submitted routing configuration to take effect, which can take up to 2 minutes.
+func waitMultiRegionAccessPointRoutesApplied(ctx context.Context, conn *s3control.Client, accountID, mrapARN string, routes []types.MultiRegionAccessPointRoute, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrapARN)
+
+		if err != nil {
+			return false, err
+		}
+
+		trafficDials := make(map[string]int32)
+		for _, route := range output {
+			trafficDials[aws.ToString(route.Region)] = aws.ToInt32(route.TrafficDialPercentage)
+		}
+
+		for _, route := range routes {
+			if v, ok := trafficDials[aws.ToString(route.Region)]; !ok || v != aws.ToInt32(route.TrafficDialPercentage) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+}
+
+func multiRegionAccessPointRouteHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%d", tfMap[names.AttrRegion].(string), tfMap["traffic_dial_percentage"].(int)))
+}
+
+func expandMultiRegionAccessPointRoutes(tfList []interface{}) []types.MultiRegionAccessPointRoute {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MultiRegionAccessPointRoute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.MultiRegionAccessPointRoute{
+			Region:                aws.String(tfMap[names.AttrRegion].(string)),
+			TrafficDialPercentage: aws.Int32(int32(tfMap["traffic_dial_percentage"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMultiRegionAccessPointRoutes(apiObjects []types.MultiRegionAccessPointRoute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrBucket:          aws.ToString(apiObject.Bucket),
+			names.AttrRegion:          aws.ToString(apiObject.Region),
+			"traffic_dial_percentage": int(aws.ToInt32(apiObject.TrafficDialPercentage)),
+		})
+	}
+
+	return tfList
+}
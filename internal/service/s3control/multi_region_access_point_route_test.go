@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlMultiRegionAccessPointRoute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_multi_region_access_point_route.test"
+	bucket1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		// Multi-Region Access Point routes cannot be deleted.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointRouteConfig_basic(bucket1Name, bucket2Name, rName, 100, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.Region(), 100),
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.AlternateRegion(), 100),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "mrap", "aws_s3control_multi_region_access_point.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						names.AttrBucket:          bucket1Name,
+						names.AttrRegion:          acctest.Region(),
+						"traffic_dial_percentage": "100",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						names.AttrBucket:          bucket2Name,
+						names.AttrRegion:          acctest.AlternateRegion(),
+						"traffic_dial_percentage": "100",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlMultiRegionAccessPointRoute_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_multi_region_access_point_route.test"
+	bucket1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointRouteConfig_basic(bucket1Name, bucket2Name, rName, 100, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.Region(), 100),
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.AlternateRegion(), 0),
+				),
+			},
+			{
+				Config: testAccMultiRegionAccessPointRouteConfig_basic(bucket1Name, bucket2Name, rName, 0, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.Region(), 0),
+					testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx, resourceName, acctest.AlternateRegion(), 100),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						names.AttrRegion:          acctest.Region(),
+						"traffic_dial_percentage": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						names.AttrRegion:          acctest.AlternateRegion(),
+						"traffic_dial_percentage": "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionAccessPointRouteTrafficDial(ctx context.Context, n, region string, want int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		mrapARN, err := arn.Parse(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		routes, err := tfs3control.FindMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, mrapARN.AccountID, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, route := range routes {
+			if aws.ToString(route.Region) == region {
+				if got := aws.ToInt32(route.TrafficDialPercentage); got != want {
+					return fmt.Errorf("S3 Multi-Region Access Point (%s) route for %s traffic dial percentage is %d, want %d", rs.Primary.ID, region, got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("S3 Multi-Region Access Point (%s) route for %s not found", rs.Primary.ID, region)
+	}
+}
+
+func testAccMultiRegionAccessPointRouteConfig_basic(bucket1Name, bucket2Name, rName string, trafficDial1, trafficDial2 int) string {
+	return acctest.ConfigCompose(testAccMultiRegionAccessPointDataSource_base(bucket1Name, bucket2Name, rName), fmt.Sprintf(`
+resource "aws_s3control_multi_region_access_point_route" "test" {
+  provider = aws
+
+  mrap = aws_s3control_multi_region_access_point.test.arn
+
+  route {
+    region                  = aws_s3_bucket.test1.region
+    traffic_dial_percentage = %[1]d
+  }
+
+  route {
+    region                  = aws_s3_bucket.test2.region
+    traffic_dial_percentage = %[2]d
+  }
+}
+`, trafficDial1, trafficDial2))
+}
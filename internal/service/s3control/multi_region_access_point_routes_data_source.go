@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3control_multi_region_access_point_routes", name="Multi-Region Access Point Routes")
+func dataSourceMultiRegionAccessPointRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMultiRegionAccessPointRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mrap": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"traffic_dial_percentage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMultiRegionAccessPointRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	mrapARN := d.Get("mrap").(string)
+	accountID, err := multiRegionAccessPointAccountID(mrapARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	routes, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrapARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Multi-Region Access Point Routes (%s): %s", mrapARN, err)
+	}
+
+	d.SetId(mrapARN)
+	d.Set(names.AttrAccountID, accountID)
+	if err := d.Set("route", flattenMultiRegionAccessPointRoutes(routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlMultiRegionAccessPointRoutesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	dataSourceName := "data.aws_s3control_multi_region_access_point_routes.test"
+	bucket1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointRoutesDataSourceConfig_basic(bucket1Name, bucket2Name, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceName, "mrap"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "route.*", map[string]string{
+						names.AttrBucket:          bucket1Name,
+						names.AttrRegion:          acctest.Region(),
+						"traffic_dial_percentage": "100",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "route.*", map[string]string{
+						names.AttrBucket:          bucket2Name,
+						names.AttrRegion:          acctest.AlternateRegion(),
+						"traffic_dial_percentage": "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiRegionAccessPointRoutesDataSourceConfig_basic(bucket1Name, bucket2Name, rName string) string {
+	return acctest.ConfigCompose(testAccMultiRegionAccessPointDataSource_base(bucket1Name, bucket2Name, rName), `
+data "aws_s3control_multi_region_access_point_routes" "test" {
+  provider = aws
+
+  mrap = aws_s3control_multi_region_access_point.test.arn
+}
+`)
+}
@@ -130,6 +130,8 @@ func resourceObjectLambdaAccessPoint() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: objectLambdaAccessPointCustomizeDiff,
 	}
 }
 
@@ -263,6 +265,36 @@ func resourceObjectLambdaAccessPointDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
+// objectLambdaAccessPointCustomizeDiff ensures that each action is handled by at most one transformation configuration.
+func objectLambdaAccessPointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("configuration.0.transformation_configuration")
+	if !ok {
+		return nil
+	}
+
+	actions := make(map[string]bool)
+	for _, tfMapRaw := range v.(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap[names.AttrActions].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, action := range flex.ExpandStringValueSet(v) {
+			if actions[action] {
+				return fmt.Errorf("action %q is specified in more than one transformation_configuration", action)
+			}
+			actions[action] = true
+		}
+	}
+
+	return nil
+}
+
 func findObjectLambdaAccessPointConfigurationByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (*types.ObjectLambdaConfiguration, error) {
 	input := &s3control.GetAccessPointConfigurationForObjectLambdaInput{
 		AccountId: aws.String(accountID),
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3control_object_lambda_access_point_policy", name="Object Lambda Access Point Policy")
+func dataSourceObjectLambdaAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceObjectLambdaAccessPointPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectLambdaAccessPointPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+	name := d.Get(names.AttrName).(string)
+	id := ObjectLambdaAccessPointCreateResourceID(accountID, name)
+
+	policy, status, err := findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey(ctx, conn, accountID, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Lambda Access Point Policy (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrAccountID, accountID)
+	d.Set("has_public_access_policy", status.IsPublic)
+	d.Set(names.AttrName, name)
+	d.Set(names.AttrPolicy, policy)
+
+	return diags
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlObjectLambdaAccessPointPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_object_lambda_access_point_policy.test"
+	dataSourceName := "data.aws_s3control_object_lambda_access_point_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLambdaAccessPointPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "has_public_access_policy", dataSourceName, "has_public_access_policy"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, dataSourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrPolicy),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectLambdaAccessPointPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointPolicyConfig_basic(rName), `
+data "aws_s3control_object_lambda_access_point_policy" "test" {
+  name = aws_s3control_object_lambda_access_point_policy.test.name
+}
+`)
+}
@@ -156,6 +156,71 @@ func TestAccS3ControlObjectLambdaAccessPoint_update(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlObjectLambdaAccessPoint_multipleTransformationConfigurations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ObjectLambdaConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectLambdaAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLambdaAccessPointConfig_multipleTransformationConfigurations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectLambdaAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.transformation_configuration.*", map[string]string{
+						"actions.#": acctest.Ct1,
+						"content_transformation.0.aws_lambda.0.function_payload": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.transformation_configuration.*", map[string]string{
+						"actions.#": acctest.Ct2,
+						"content_transformation.0.aws_lambda.0.function_payload": "metadata",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "GetObject"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "HeadObject"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "ListObjects"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObjectLambdaAccessPointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectLambdaAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "GetObject"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlObjectLambdaAccessPoint_duplicateActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectLambdaAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectLambdaAccessPointConfig_duplicateActions(rName),
+				ExpectError: regexache.MustCompile(`action "GetObject" is specified in more than one transformation_configuration`),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectLambdaAccessPointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -289,3 +354,87 @@ resource "aws_s3control_object_lambda_access_point" "test" {
 }
 `, rName))
 }
+
+func testAccObjectLambdaAccessPointConfig_multipleTransformationConfigurations(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+}
+
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+
+    transformation_configuration {
+      actions = ["HeadObject", "ListObjects"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn     = aws_lambda_function.test.arn
+          function_payload = "metadata"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccObjectLambdaAccessPointConfig_duplicateActions(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+}
+
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+
+    transformation_configuration {
+      actions = ["GetObject", "HeadObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn     = aws_lambda_function.test.arn
+          function_payload = "metadata"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -15,7 +15,20 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newAccessGrantDataSource,
+			Name:    "Access Grant",
+		},
+		{
+			Factory: newAccessGrantsInstanceDataSource,
+			Name:    "Access Grants Instance",
+		},
+		{
+			Factory: newAccessGrantsLocationDataSource,
+			Name:    "Access Grants Location",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -39,6 +52,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Name:    "Access Grants Location",
 			Tags:    &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory: newStorageLensGroupResource,
+			Name:    "Storage Lens Group",
+			Tags:    &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -53,6 +71,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
 		},
+		{
+			Factory:  dataSourceMultiRegionAccessPointRoutes,
+			TypeName: "aws_s3control_multi_region_access_point_routes",
+			Name:     "Multi-Region Access Point Routes",
+		},
+		{
+			Factory:  dataSourceObjectLambdaAccessPointPolicy,
+			TypeName: "aws_s3control_object_lambda_access_point_policy",
+			Name:     "Object Lambda Access Point Policy",
+		},
 	}
 }
 
@@ -67,6 +95,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_account_public_access_block",
 			Name:     "Account Public Access Block",
 		},
+		{
+			Factory:  resourceBatchOperationsJob,
+			TypeName: "aws_s3batch_operations_job",
+			Name:     "Batch Operations Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceAccessPointPolicy,
 			TypeName: "aws_s3control_access_point_policy",
@@ -93,6 +127,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceMultiRegionAccessPointPolicy,
 			TypeName: "aws_s3control_multi_region_access_point_policy",
 		},
+		{
+			Factory:  resourceMultiRegionAccessPointRoute,
+			TypeName: "aws_s3control_multi_region_access_point_route",
+			Name:     "Multi-Region Access Point Route",
+		},
 		{
 			Factory:  resourceObjectLambdaAccessPoint,
 			TypeName: "aws_s3control_object_lambda_access_point",
@@ -6,6 +6,7 @@ package scheduler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -15,9 +16,11 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,7 +47,18 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateFlexibleTimeWindow,
+			validateTarget,
+		),
+
 		Schema: map[string]*schema.Schema{
+			"action_after_completion": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ActionAfterCompletionNone,
+				ValidateDiagFunc: enum.Validate[types.ActionAfterCompletion](),
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -442,6 +456,10 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 		ScheduleExpression: aws.String(d.Get(names.AttrScheduleExpression).(string)),
 	}
 
+	if v, ok := d.Get("action_after_completion").(string); ok && v != "" {
+		in.ActionAfterCompletion = types.ActionAfterCompletion(v)
+	}
+
 	if v, ok := d.Get(names.AttrDescription).(string); ok && v != "" {
 		in.Description = aws.String(v)
 	}
@@ -532,6 +550,7 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, ResNameSchedule, d.Id(), err)
 	}
 
+	d.Set("action_after_completion", out.ActionAfterCompletion)
 	d.Set(names.AttrARN, out.Arn)
 	d.Set(names.AttrDescription, out.Description)
 
@@ -579,6 +598,10 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		Target:             expandTarget(ctx, d.Get(names.AttrTarget).([]interface{})[0].(map[string]interface{})),
 	}
 
+	if v, ok := d.Get("action_after_completion").(string); ok && v != "" {
+		in.ActionAfterCompletion = types.ActionAfterCompletion(v)
+	}
+
 	if v, ok := d.Get(names.AttrDescription).(string); ok && v != "" {
 		in.Description = aws.String(v)
 	}
@@ -700,6 +723,99 @@ func ResourceScheduleParseID(id string) (groupName, scheduleName string, err err
 	return parts[0], parts[1], nil
 }
 
+func validateFlexibleTimeWindow(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("flexible_time_window.0.maximum_window_in_minutes") || !d.NewValueKnown("flexible_time_window.0.mode") {
+		return nil
+	}
+
+	tfList, ok := d.Get("flexible_time_window").([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	maximumWindow := tfMap["maximum_window_in_minutes"].(int)
+
+	switch types.FlexibleTimeWindowMode(tfMap[names.AttrMode].(string)) {
+	case types.FlexibleTimeWindowModeFlexible:
+		if maximumWindow == 0 {
+			return errors.New(`flexible_time_window.0.maximum_window_in_minutes is required when mode is "FLEXIBLE"`)
+		}
+	case types.FlexibleTimeWindowModeOff:
+		if maximumWindow != 0 {
+			return errors.New(`flexible_time_window.0.maximum_window_in_minutes must not be set when mode is "OFF"`)
+		}
+	}
+
+	return nil
+}
+
+// universalTargetResourceRegexp matches the resource part of a universal target ARN,
+// e.g. "aws-sdk:sqs:sendMessage" in arn:aws:scheduler:::aws-sdk:sqs:sendMessage.
+var universalTargetResourceRegexp = regexache.MustCompile(`^aws-sdk:[0-9a-z-]+:[a-z][0-9A-Za-z]*$`)
+
+// templatedTargetParameters are the target blocks that only apply to templated targets.
+var templatedTargetParameters = []string{
+	"ecs_parameters",
+	"eventbridge_parameters",
+	"kinesis_parameters",
+	"sagemaker_pipeline_parameters",
+	"sqs_parameters",
+}
+
+func validateTarget(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfList, ok := d.Get(names.AttrTarget).([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if d.NewValueKnown("target.0.role_arn") {
+		if v := tfMap[names.AttrRoleARN].(string); v != "" {
+			roleARN, err := arn.Parse(v)
+			if err != nil {
+				return fmt.Errorf("parsing target.0.role_arn (%s): %w", v, err)
+			}
+
+			if roleARN.Service != "iam" || !strings.HasPrefix(roleARN.Resource, "role/") {
+				return fmt.Errorf("target.0.role_arn (%s) is not an IAM role ARN", v)
+			}
+		}
+	}
+
+	if !d.NewValueKnown("target.0.arn") {
+		return nil
+	}
+
+	targetARN, err := arn.Parse(tfMap[names.AttrARN].(string))
+	if err != nil || targetARN.Service != "scheduler" || !strings.HasPrefix(targetARN.Resource, "aws-sdk:") {
+		// Not a universal target.
+		return nil
+	}
+
+	if targetARN.Region != "" || targetARN.AccountID != "" || !universalTargetResourceRegexp.MatchString(targetARN.Resource) {
+		return fmt.Errorf("target.0.arn (%s) is not a valid universal target ARN, expected arn:PARTITION:scheduler:::aws-sdk:SERVICE:API_ACTION", targetARN)
+	}
+
+	for _, k := range templatedTargetParameters {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+			return fmt.Errorf("target.0.%s cannot be used with universal target %s", k, targetARN)
+		}
+	}
+
+	if d.NewValueKnown("target.0.input") {
+		if v := tfMap["input"].(string); v != "" {
+			var input map[string]interface{}
+			if err := json.Unmarshal([]byte(v), &input); err != nil {
+				return fmt.Errorf("target.0.input must be a JSON object of %s request parameters: %w", targetARN.Resource[len("aws-sdk:"):], err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func sagemakerPipelineParameterHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(fmt.Sprintf("%s-%s", m[names.AttrName].(string), m[names.AttrValue].(string)))
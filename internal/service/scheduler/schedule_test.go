@@ -202,6 +202,7 @@ func TestAccSchedulerSchedule_basic(t *testing.T) {
 				Config: testAccScheduleConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "action_after_completion", "NONE"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "scheduler", regexache.MustCompile(regexp.QuoteMeta(`schedule/default/`+name))),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
 					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
@@ -269,6 +270,49 @@ func TestAccSchedulerSchedule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_actionAfterCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_actionAfterCompletion(name, "DELETE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "action_after_completion", "DELETE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_actionAfterCompletion(name, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "action_after_completion", "NONE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1587,6 +1631,40 @@ func TestAccSchedulerSchedule_targetSQSParameters(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_planTimeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMissingMaximum(name),
+				ExpectError: regexache.MustCompile(`maximum_window_in_minutes is required when mode is "FLEXIBLE"`),
+			},
+			{
+				Config:      testAccScheduleConfig_universalTarget(name, "aws-sdk:sqs:SendMessage", `{}`),
+				ExpectError: regexache.MustCompile(`is not a valid universal target ARN`),
+			},
+			{
+				Config:      testAccScheduleConfig_universalTarget(name, "aws-sdk:sqs:sendMessage", `not-json`),
+				ExpectError: regexache.MustCompile(`target.0.input must be a JSON object`),
+			},
+			{
+				Config:      testAccScheduleConfig_universalTargetSQSParameters(name),
+				ExpectError: regexache.MustCompile(`target.0.sqs_parameters cannot be used with universal target`),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
@@ -1697,6 +1775,32 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_actionAfterCompletion(name, actionAfterCompletion string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  action_after_completion = %[2]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, actionAfterCompletion),
+	)
+}
+
 func testAccScheduleConfig_description(name, description string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
@@ -2594,3 +2698,76 @@ resource "aws_scheduler_schedule" "test" {
 `, name, messageGroupId),
 	)
 }
+
+func testAccScheduleConfig_flexibleTimeWindowMissingMaximum(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "FLEXIBLE"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_universalTarget(name, resource, input string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:scheduler:::%[2]s"
+    role_arn = aws_iam_role.test.arn
+    input    = %[3]q
+  }
+}
+`, name, resource, input),
+	)
+}
+
+func testAccScheduleConfig_universalTargetSQSParameters(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:scheduler:::aws-sdk:sqs:sendMessage"
+    role_arn = aws_iam_role.test.arn
+
+    sqs_parameters {
+      message_group_id = "test"
+    }
+  }
+}
+`, name),
+	)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_scheduler_schedules", name="Schedules")
+func dataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrGroupName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrGroupName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modification_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+			},
+		},
+	}
+}
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.scheduler-in-func-name
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	input := &scheduler.ListSchedulesInput{}
+
+	if v, ok := d.GetOk(names.AttrGroupName); ok {
+		input.GroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
+		input.NamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrState); ok {
+		input.State = types.ScheduleState(v.(string))
+	}
+
+	schedules, err := findSchedules(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Scheduler Schedules: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("schedules", flattenScheduleSummaries(schedules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedules: %s", err)
+	}
+
+	return diags
+}
+
+func findSchedules(ctx context.Context, conn *scheduler.Client, input *scheduler.ListSchedulesInput) ([]types.ScheduleSummary, error) {
+	var output []types.ScheduleSummary
+
+	pages := scheduler.NewListSchedulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Schedules...)
+	}
+
+	return output, nil
+}
+
+func flattenScheduleSummaries(apiObjects []types.ScheduleSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:       aws.ToString(apiObject.Arn),
+			names.AttrGroupName: aws.ToString(apiObject.GroupName),
+			names.AttrName:      aws.ToString(apiObject.Name),
+			names.AttrState:     string(apiObject.State),
+		}
+
+		if v := apiObject.CreationDate; v != nil {
+			tfMap[names.AttrCreationDate] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastModificationDate; v != nil {
+			tfMap["last_modification_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.Target; v != nil {
+			tfMap["target_arn"] = aws.ToString(v.Arn)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "schedules.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.arn", resourceName+".0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.arn", resourceName+".1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedules.0.group_name", "aws_scheduler_schedule_group.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedules.0.target_arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "schedules.0.state", "ENABLED"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "schedules.0.creation_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "schedules.0.last_modification_date"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.disabled", "schedules.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig_basic(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+data "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+
+data "aws_scheduler_schedules" "disabled" {
+  group_name = aws_scheduler_schedule_group.test.name
+  state      = "DISABLED"
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+`, name),
+	)
+}
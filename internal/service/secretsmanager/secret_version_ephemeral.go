@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_secretsmanager_secret_version", name="Secret Version")
+func newSecretVersionEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &secretVersionEphemeralResource{}, nil
+}
+
+type secretVersionEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*secretVersionEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_secret_version"
+}
+
+func (e *secretVersionEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_id": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_stage")),
+				},
+			},
+			"version_stage": schema.StringAttribute{
+				Optional: true,
+			},
+			"version_stages": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *secretVersionEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data secretVersionEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	secretID := data.SecretID.ValueString()
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+	var version string
+	if !data.VersionID.IsNull() {
+		version = data.VersionID.ValueString()
+		input.VersionId = aws.String(version)
+	} else {
+		version = secretVersionStageCurrent
+		if !data.VersionStage.IsNull() {
+			version = data.VersionStage.ValueString()
+		}
+		input.VersionStage = aws.String(version)
+	}
+
+	id := secretVersionCreateResourceID(secretID, version)
+	output, err := findSecretVersion(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret Version (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.ARN)
+	data.CreatedDate = timetypes.NewRFC3339TimePointerValue(output.CreatedDate)
+	data.SecretBinary = types.StringValue(string(output.SecretBinary))
+	data.SecretString = fwflex.StringToFramework(ctx, output.SecretString)
+	data.VersionID = fwflex.StringToFramework(ctx, output.VersionId)
+	data.VersionStages = fwflex.FlattenFrameworkStringValueSet(ctx, output.VersionStages)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type secretVersionEphemeralResourceModel struct {
+	ARN           types.String      `tfsdk:"arn"`
+	CreatedDate   timetypes.RFC3339 `tfsdk:"created_date"`
+	SecretBinary  types.String      `tfsdk:"secret_binary"`
+	SecretID      types.String      `tfsdk:"secret_id"`
+	SecretString  types.String      `tfsdk:"secret_string"`
+	VersionID     types.String      `tfsdk:"version_id"`
+	VersionStage  types.String      `tfsdk:"version_stage"`
+	VersionStages types.Set         `tfsdk:"version_stages"`
+}
@@ -5,11 +5,13 @@ package sfn
 
 // Exports for use in tests only.
 var (
-	ResourceActivity     = resourceActivity
-	ResourceAlias        = resourceAlias
-	ResourceStateMachine = resourceStateMachine
+	ResourceActivity            = resourceActivity
+	ResourceAlias               = resourceAlias
+	ResourceStateMachine        = resourceStateMachine
+	ResourceStateMachineVersion = resourceStateMachineVersion
 
-	FindActivityByARN     = findActivityByARN
-	FindAliasByARN        = findAliasByARN
-	FindStateMachineByARN = findStateMachineByARN
+	FindActivityByARN            = findActivityByARN
+	FindAliasByARN               = findAliasByARN
+	FindStateMachineByARN        = findStateMachineByARN
+	FindStateMachineVersionByARN = findStateMachineVersionByARN
 )
@@ -70,6 +70,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceStateMachineVersion,
+			TypeName: "aws_sfn_state_machine_version",
+			Name:     "State Machine Version",
+		},
 	}
 }
 
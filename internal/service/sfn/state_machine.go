@@ -5,6 +5,7 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -13,7 +14,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -189,7 +192,10 @@ func resourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateStateMachineDefinition,
+		),
 	}
 }
 
@@ -402,6 +408,61 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// validateStateMachineDefinition checks the definition's Amazon States Language syntax at plan time,
+// so that errors are reported before any resources are changed.
+func validateStateMachineDefinition(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Definitions that interpolate values not yet known are validated by the service at apply time.
+	if !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("definition") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(d.Get("definition").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrType); ok {
+		input.Type = awstypes.StateMachineType(v.(string))
+	}
+
+	output, err := conn.ValidateStateMachineDefinition(ctx, input)
+
+	// Validation is best effort; don't require the additional permission.
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Skipping Step Functions State Machine definition validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	if output.Result != awstypes.ValidateStateMachineDefinitionResultCodeFail {
+		return nil
+	}
+
+	if len(output.Diagnostics) == 0 {
+		return errors.New("invalid Step Functions State Machine definition")
+	}
+
+	var validationErrs []error
+
+	for _, v := range output.Diagnostics {
+		if location := aws.ToString(v.Location); location != "" {
+			validationErrs = append(validationErrs, fmt.Errorf("%s at %s: %s", aws.ToString(v.Code), location, aws.ToString(v.Message)))
+		} else {
+			validationErrs = append(validationErrs, fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Message)))
+		}
+	}
+
+	return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(validationErrs...))
+}
+
 func findStateMachineByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -593,6 +593,37 @@ func TestAccSFNStateMachine_encryptionConfigurationServiceOwnedKey(t *testing.T)
 	})
 }
 
+func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_definitionValidation(rName, "Missing"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition`),
+			},
+			{
+				Config: testAccStateMachineConfig_definitionValidation(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+				),
+			},
+			{
+				Config:      testAccStateMachineConfig_definitionValidation(rName, "Missing"),
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1227,3 +1258,24 @@ EOF
 }
 `, rName, rType))
 }
+
+func testAccStateMachineConfig_definitionValidation(rName, startAt string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "StartAt": %[2]q,
+  "States": {
+    "Hello": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, startAt))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sfn_state_machine_version", name="State Machine Version")
+func resourceStateMachineVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStateMachineVersionCreate,
+		ReadWithoutTimeout:   resourceStateMachineVersionRead,
+		UpdateWithoutTimeout: resourceStateMachineVersionUpdate,
+		DeleteWithoutTimeout: resourceStateMachineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_destroy", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state_machine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceStateMachineVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	stateMachineARN := d.Get("state_machine_arn").(string)
+	input := &sfn.PublishStateMachineVersionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("revision_id"); ok {
+		input.RevisionId = aws.String(v.(string))
+	}
+
+	output, err := conn.PublishStateMachineVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "publishing Step Functions State Machine (%s) Version: %s", stateMachineARN, err)
+	}
+
+	d.SetId(aws.ToString(output.StateMachineVersionArn))
+
+	return append(diags, resourceStateMachineVersionRead(ctx, d, meta)...)
+}
+
+func resourceStateMachineVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	output, err := findStateMachineVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Step Functions State Machine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions State Machine Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.StateMachineArn)
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set("definition", output.Definition)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("revision_id", output.RevisionId)
+	d.Set("skip_destroy", d.Get("skip_destroy").(bool))
+	d.Set("state_machine_arn", stateMachineARNFromVersionARN(d.Id()))
+
+	return diags
+}
+
+func resourceStateMachineVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only skip_destroy can be updated, and it is not sent to the API.
+	return resourceStateMachineVersionRead(ctx, d, meta)
+}
+
+func resourceStateMachineVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining Step Functions State Machine Version: %s", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Step Functions State Machine Version: %s", d.Id())
+	_, err := conn.DeleteStateMachineVersion(ctx, &sfn.DeleteStateMachineVersionInput{
+		StateMachineVersionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) || errs.IsA[*awstypes.StateMachineDoesNotExist](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Step Functions State Machine Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStateMachineVersionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeStateMachineOutput, error) {
+	output, err := findStateMachineByARN(ctx, conn, arn)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	return output, err
+}
+
+// stateMachineARNFromVersionARN returns the ARN of the state machine that a version belongs to.
+// Version ARNs have the form arn:aws:states:region:account:stateMachine:name:version.
+func stateMachineARNFromVersionARN(arn string) string {
+	i := strings.LastIndex(arn, ":")
+	if i == -1 {
+		return arn
+	}
+
+	return arn[:i]
+}
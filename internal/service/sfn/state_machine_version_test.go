@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+	stateMachineResourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "revision_id", stateMachineResourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "state_machine_arn", stateMachineResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+			{
+				Config: testAccStateMachineVersionConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "revision_id", stateMachineResourceName, "revision_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsfn.ResourceStateMachineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersion_aliasCanary(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stable, canary sfn.DescribeStateMachineOutput
+	var alias sfn.DescribeStateMachineAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stableResourceName := "aws_sfn_state_machine_version.stable"
+	canaryResourceName := "aws_sfn_state_machine_version.canary"
+	aliasResourceName := "aws_sfn_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_aliasStable(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, stableResourceName, &stable),
+					testAccCheckAliasExists(ctx, aliasResourceName, &alias),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.0.state_machine_version_arn", stableResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+			{
+				Config: testAccStateMachineVersionConfig_aliasCanary(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, stableResourceName, &stable),
+					testAccCheckStateMachineVersionExists(ctx, canaryResourceName, &canary),
+					testAccCheckAliasExists(ctx, aliasResourceName, &alias),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.0.state_machine_version_arn", stableResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.0.weight", "90"),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.1.state_machine_version_arn", canaryResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.1.weight", acctest.Ct10),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStateMachineVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sfn_state_machine_version" {
+				continue
+			}
+
+			_, err := tfsfn.FindStateMachineVersionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Step Functions State Machine Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStateMachineVersionExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		output, err := tfsfn.FindStateMachineVersionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccStateMachineVersionConfig_basic(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, rMaxAttempts), `
+resource "aws_sfn_state_machine_version" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+  description       = "first"
+}
+`)
+}
+
+func testAccStateMachineVersionConfig_aliasStable(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_state_machine_version" "stable" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+
+  lifecycle {
+    ignore_changes = [revision_id]
+  }
+}
+
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine_version.stable.arn
+    weight                    = 100
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineVersionConfig_aliasCanary(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_state_machine_version" "stable" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+
+  lifecycle {
+    ignore_changes = [revision_id]
+  }
+}
+
+resource "aws_sfn_state_machine_version" "canary" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+}
+
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine_version.stable.arn
+    weight                    = 90
+  }
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine_version.canary.arn
+    weight                    = 10
+  }
+}
+`, rName))
+}
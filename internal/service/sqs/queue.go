@@ -189,6 +189,7 @@ var (
 )
 
 // @SDKResource("aws_sqs_queue", name="Queue")
+// @Region
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
 func resourceQueue() *schema.Resource {
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKDataSource("aws_sqs_queue")
+// @Region
 // @Tags(identifierAttribute="url")
 func dataSourceQueue() *schema.Resource {
 	return &schema.Resource{
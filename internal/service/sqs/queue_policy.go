@@ -13,6 +13,7 @@ import (
 )
 
 // @SDKResource("aws_sqs_queue_policy")
+// @Region
 func resourceQueuePolicy() *schema.Resource {
 	h := &queueAttributeHandler{
 		AttributeName: types.QueueAttributeNamePolicy,
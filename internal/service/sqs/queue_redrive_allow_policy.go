@@ -12,6 +12,7 @@ import (
 )
 
 // @SDKResource("aws_sqs_queue_redrive_allow_policy")
+// @Region
 func resourceQueueRedriveAllowPolicy() *schema.Resource {
 	h := &queueAttributeHandler{
 		AttributeName: types.QueueAttributeNameRedriveAllowPolicy,
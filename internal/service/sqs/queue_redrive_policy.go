@@ -12,6 +12,7 @@ import (
 )
 
 // @SDKResource("aws_sqs_queue_redrive_policy")
+// @Region
 func resourceQueueRedrivePolicy() *schema.Resource {
 	h := &queueAttributeHandler{
 		AttributeName: types.QueueAttributeNameRedrivePolicy,
@@ -142,6 +142,46 @@ func TestAccSQSQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_region(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestMatchResourceAttr(resourceName, names.AttrARN, regexache.MustCompile(`^arn:[^:]+:sqs:`+acctest.AlternateRegion()+`:`)),
+					resource.TestCheckResourceAttr("data.aws_sqs_queue.test", names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair("data.aws_sqs_queue.test", names.AttrARN, resourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccQueueImportStateIDRegionFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.Region()),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "sqs", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -916,6 +956,31 @@ resource "aws_sqs_queue" "test" {
 `, rName)
 }
 
+func testAccQueueConfig_region(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name   = %[1]q
+  region = %[2]q
+}
+
+data "aws_sqs_queue" "test" {
+  name   = aws_sqs_queue.test.name
+  region = aws_sqs_queue.test.region
+}
+`, rName, region)
+}
+
+func testAccQueueImportStateIDRegionFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.ID + "@" + rs.Primary.Attributes[names.AttrRegion], nil
+	}
+}
+
 func testAccQueueConfig_namePrefix(prefix string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ssm_parameter", name="Parameter")
+func newParameterEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &parameterEphemeralResource{}, nil
+}
+
+type parameterEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*parameterEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *parameterEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *parameterEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data parameterEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMClient(ctx)
+
+	name := data.Name.ValueString()
+	// Parameters are decrypted unless decryption is explicitly disabled.
+	withDecryption := data.WithDecryption.IsNull() || data.WithDecryption.ValueBool()
+	parameter, err := findParameterByName(ctx, conn, name, withDecryption)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, parameter.ARN)
+	data.Type = types.StringValue(string(parameter.Type))
+	data.Value = fwflex.StringToFramework(ctx, parameter.Value)
+	data.Version = types.Int64Value(parameter.Version)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type parameterEphemeralResourceModel struct {
+	ARN            types.String `tfsdk:"arn"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_region.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		CheckDestroy: testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The parameter value is consumed by a provider configuration, which can reference ephemeral values.
+				Config: testAccParameterEphemeralConfig_basic(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
+func testAccParameterEphemeralConfig_basic(rName, value string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = %[2]q
+}
+
+ephemeral "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.name
+}
+
+provider "aws" {
+  alias = "parameter"
+
+  region = ephemeral.aws_ssm_parameter.test.value
+}
+
+data "aws_region" "test" {
+  provider = aws.parameter
+}
+`, rName, value)
+}
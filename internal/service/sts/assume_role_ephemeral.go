@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newAssumeRoleEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*assumeRoleEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	webIdentityConflicts := []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot("web_identity_token")),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: append([]validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+				}, webIdentityConflicts...),
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				ElementType: fwtypes.ARNType,
+				Optional:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: append([]validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				}, webIdentityConflicts...),
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("web_identity_token")),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("web_identity_token")),
+				},
+			},
+			"web_identity_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 20000),
+				},
+			},
+		},
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	roleARN := data.RoleARN.ValueString()
+	var policyARNs []awstypes.PolicyDescriptorType
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		policyARNs = append(policyARNs, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	var credentials *awstypes.Credentials
+	var assumedRoleUser *awstypes.AssumedRoleUser
+
+	if !data.WebIdentityToken.IsNull() {
+		input := &sts.AssumeRoleWithWebIdentityInput{
+			DurationSeconds:  fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+			Policy:           fwflex.StringFromFramework(ctx, data.Policy),
+			PolicyArns:       policyARNs,
+			RoleArn:          aws.String(roleARN),
+			RoleSessionName:  fwflex.StringFromFramework(ctx, data.RoleSessionName),
+			WebIdentityToken: fwflex.StringFromFramework(ctx, data.WebIdentityToken),
+		}
+
+		output, err := conn.AssumeRoleWithWebIdentity(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s) with web identity", roleARN), err.Error())
+
+			return
+		}
+
+		credentials, assumedRoleUser = output.Credentials, output.AssumedRoleUser
+	} else {
+		input := &sts.AssumeRoleInput{
+			DurationSeconds:   fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+			ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+			Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+			PolicyArns:        policyARNs,
+			RoleArn:           aws.String(roleARN),
+			RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+			SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+			TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+		}
+
+		for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+			input.Tags = append(input.Tags, awstypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		output, err := conn.AssumeRole(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), err.Error())
+
+			return
+		}
+
+		credentials, assumedRoleUser = output.Credentials, output.AssumedRoleUser
+	}
+
+	if credentials == nil || assumedRoleUser == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), "empty result")
+
+		return
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.AssumedRoleARN = fwflex.StringToFramework(ctx, assumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, assumedRoleUser.AssumedRoleId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	AccessKeyID       types.String      `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String      `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String      `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int64       `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339 `tfsdk:"expiration"`
+	ExternalID        types.String      `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy `tfsdk:"policy"`
+	PolicyARNs        types.Set         `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN       `tfsdk:"role_arn"`
+	RoleSessionName   types.String      `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String      `tfsdk:"secret_access_key"`
+	SessionToken      types.String      `tfsdk:"session_token"`
+	SourceIdentity    types.String      `tfsdk:"source_identity"`
+	Tags              types.Map         `tfsdk:"tags"`
+	TransitiveTagKeys types.Set         `tfsdk:"transitive_tag_keys"`
+	WebIdentityToken  types.String      `tfsdk:"web_identity_token"`
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_caller_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_basic(os.Getenv(envvar.AccAssumeRoleARN), rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckCallerIdentityAccountID(dataSourceName),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrARN, regexache.MustCompile(`:assumed-role/.+/`+rName+`$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_webIdentity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				// An unsigned token is rejected by STS, which proves that AssumeRoleWithWebIdentity was called.
+				Config:      testAccAssumeRoleEphemeralConfig_webIdentity(os.Getenv(envvar.AccAssumeRoleARN), rName),
+				ExpectError: regexache.MustCompile(`assuming IAM Role \(.+\) with web identity.*InvalidIdentityToken`),
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_webIdentityConflicts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssumeRoleEphemeralConfig_webIdentityConflicts(rName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleEphemeralConfig_basic(roleARN, sessionName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn          = %[1]q
+  role_session_name = %[2]q
+}
+
+provider "aws" {
+  alias = "assumed"
+
+  access_key = ephemeral.aws_sts_assume_role.test.access_key_id
+  secret_key = ephemeral.aws_sts_assume_role.test.secret_access_key
+  token      = ephemeral.aws_sts_assume_role.test.session_token
+}
+
+data "aws_caller_identity" "test" {
+  provider = aws.assumed
+}
+`, roleARN, sessionName)
+}
+
+func testAccAssumeRoleEphemeralConfig_webIdentity(roleARN, sessionName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn           = %[1]q
+  role_session_name  = %[2]q
+  web_identity_token = "eyJhbGciOiJub25lIn0.eyJzdWIiOiJ0ZXN0In0."
+}
+
+provider "aws" {
+  alias = "assumed"
+
+  access_key = ephemeral.aws_sts_assume_role.test.access_key_id
+  secret_key = ephemeral.aws_sts_assume_role.test.secret_access_key
+  token      = ephemeral.aws_sts_assume_role.test.session_token
+}
+
+data "aws_caller_identity" "test" {
+  provider = aws.assumed
+}
+`, roleARN, sessionName)
+}
+
+func testAccAssumeRoleEphemeralConfig_webIdentityConflicts(sessionName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn           = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  role_session_name  = %[1]q
+  external_id        = "conflicts"
+  web_identity_token = "eyJhbGciOiJub25lIn0.eyJzdWIiOiJ0ZXN0In0."
+}
+`, sessionName)
+}
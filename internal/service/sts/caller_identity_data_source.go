@@ -5,8 +5,10 @@ package sts
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -42,10 +44,26 @@ func (d *dataSourceCallerIdentity) Schema(ctx context.Context, req datasource.Sc
 			names.AttrARN: schema.StringAttribute{
 				Computed: true,
 			},
+			"assume_role_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"dns_suffix": schema.StringAttribute{
+				Computed: true,
+			},
 			names.AttrID: schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"session_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Computed: true,
+			},
 			"user_id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -78,14 +96,43 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 	data.AccountID = types.StringValue(accountID)
 	data.ARN = flex.StringToFrameworkLegacy(ctx, output.Arn)
 	data.ID = types.StringValue(accountID)
+	data.AssumeRoleTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, d.Meta().AssumeRoleSessionTags(ctx))
+	data.DNSSuffix = types.StringValue(d.Meta().DNSSuffix(ctx))
+	data.Partition = types.StringValue(d.Meta().Partition)
+	data.SessionName = types.StringValue(sessionNameFromARN(aws.ToString(output.Arn)))
+	data.SourceIdentity = types.StringValue(d.Meta().AssumeRoleSourceIdentity(ctx))
 	data.UserID = flex.StringToFrameworkLegacy(ctx, output.UserId)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceCallerIdentityData struct {
-	AccountID types.String `tfsdk:"account_id"`
-	ARN       types.String `tfsdk:"arn"`
-	ID        types.String `tfsdk:"id"`
-	UserID    types.String `tfsdk:"user_id"`
+	AccountID      types.String `tfsdk:"account_id"`
+	ARN            types.String `tfsdk:"arn"`
+	AssumeRoleTags types.Map    `tfsdk:"assume_role_tags"`
+	DNSSuffix      types.String `tfsdk:"dns_suffix"`
+	ID             types.String `tfsdk:"id"`
+	Partition      types.String `tfsdk:"partition"`
+	SessionName    types.String `tfsdk:"session_name"`
+	SourceIdentity types.String `tfsdk:"source_identity"`
+	UserID         types.String `tfsdk:"user_id"`
+}
+
+// sessionNameFromARN returns the role session name from an assumed role ARN,
+// e.g. "arn:aws:sts::123456789012:assumed-role/RoleName/SessionName".
+// An empty string is returned for other caller identities.
+func sessionNameFromARN(s string) string {
+	v, err := arn.Parse(s)
+
+	if err != nil || v.Service != "sts" {
+		return ""
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) < 3 || parts[0] != "assumed-role" {
+		return ""
+	}
+
+	return parts[len(parts)-1]
 }
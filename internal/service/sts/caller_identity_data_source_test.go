@@ -11,11 +11,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestSessionNameFromARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn  string
+		want string
+	}{
+		"empty": {},
+		"invalid ARN": {
+			arn: "invalid",
+		},
+		"IAM user": {
+			arn: "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+		},
+		"assumed role": {
+			arn:  "arn:aws:sts::123456789012:assumed-role/example/session-name", //lintignore:AWSAT005
+			want: "session-name",
+		},
+		"federated user": {
+			arn: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsts.SessionNameFromARN(testCase.arn), testCase.want; got != want {
+				t.Errorf("SessionNameFromARN(%q) = %q, want %q", testCase.arn, got, want)
+			}
+		})
+	}
+}
+
 func TestAccSTSCallerIdentityDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_caller_identity.current"
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
@@ -24,7 +63,9 @@ func TestAccSTSCallerIdentityDataSource_basic(t *testing.T) {
 			{
 				Config: testAccCallerIdentityConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
-					acctest.CheckCallerIdentityAccountID("data.aws_caller_identity.current"),
+					acctest.CheckCallerIdentityAccountID(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "dns_suffix", acctest.PartitionDNSSuffix()),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
 				),
 			},
 		},
@@ -59,6 +100,31 @@ func TestAccSTSCallerIdentityDataSource_alternateRegion(t *testing.T) {
 	})
 }
 
+func TestAccSTSCallerIdentityDataSource_assumeRoleTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_caller_identity.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallerIdentityConfig_assumeRoleTags(os.Getenv(envvar.AccAssumeRoleARN), "Project", "abac"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckCallerIdentityAccountID(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "assume_role_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "assume_role_tags.Project", "abac"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "session_name"),
+				),
+			},
+		},
+	})
+}
+
 const testAccCallerIdentityConfig_basic = `
 data "aws_caller_identity" "current" {}
 `
@@ -78,3 +144,22 @@ provider "aws" {
 data "aws_caller_identity" "current" {}
 `, defaultRegion, alternateRegion)
 }
+
+func testAccCallerIdentityConfig_assumeRoleTags(roleARN, tagKey, tagValue string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  assume_role {
+    role_arn = %[1]q
+
+    tags = {
+      %[2]q = %[3]q
+    }
+
+    transitive_tag_keys = [%[2]q]
+  }
+}
+
+data "aws_caller_identity" "current" {}
+`, roleARN, tagKey, tagValue)
+}
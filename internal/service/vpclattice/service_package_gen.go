@@ -49,6 +49,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_vpclattice_service_network",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceTargetGroup,
+			TypeName: "aws_vpclattice_target_group",
+			Name:     "Target Group",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
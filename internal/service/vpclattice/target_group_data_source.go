@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_vpclattice_target_group", name="Target Group")
+// @Tags
+func dataSourceTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTargetGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrHealthCheck: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"health_check_interval_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"health_check_timeout_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"healthy_threshold_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"matcher": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									names.AttrPath: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrPort: {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									names.AttrProtocol: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"unhealthy_threshold_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrIPAddressType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lambda_event_structure_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrProtocol: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "target_group_identifier"},
+			},
+			"service_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_group_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "target_group_identifier"},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	var out *vpclattice.GetTargetGroupOutput
+	if v, ok := d.GetOk("target_group_identifier"); ok {
+		targetGroup, err := FindTargetGroupByID(ctx, conn, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		out = targetGroup
+	} else if v, ok := d.GetOk(names.AttrName); ok {
+		filter := func(x types.TargetGroupSummary) bool {
+			return aws.ToString(x.Name) == v.(string)
+		}
+		output, err := findTargetGroup(ctx, conn, filter)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		targetGroup, err := FindTargetGroupByID(ctx, conn, aws.ToString(output.Id))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		out = targetGroup
+	}
+
+	d.SetId(aws.ToString(out.Id))
+	targetGroupARN := aws.ToString(out.Arn)
+	d.Set(names.AttrARN, targetGroupARN)
+	if out.Config != nil {
+		if err := d.Set("config", []interface{}{flattenTargetGroupConfig(out.Config)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting config: %s", err)
+		}
+	} else {
+		d.Set("config", nil)
+	}
+	d.Set(names.AttrName, out.Name)
+	d.Set("service_arns", out.ServiceArns)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set("target_group_identifier", out.Id)
+	d.Set(names.AttrType, out.Type)
+
+	// https://docs.aws.amazon.com/vpc-lattice/latest/ug/sharing.html#sharing-perms
+	// Tags can only be listed for resources created by the account.
+	parsedARN, err := arn.Parse(targetGroupARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if parsedARN.AccountID == meta.(*conns.AWSClient).AccountID {
+		tags, err := listTags(ctx, conn, targetGroupARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for VPC Lattice Target Group (%s): %s", targetGroupARN, err)
+		}
+
+		setTagsOut(ctx, Tags(tags))
+	}
+
+	return diags
+}
@@ -4,7 +4,9 @@
 package slices
 
 import (
+	"errors"
 	"slices"
+	"sync"
 )
 
 // Reverse returns a reversed copy of the slice `s`.
@@ -57,6 +59,41 @@ func ApplyToAllWithError[S ~[]E1, E1, E2 any](s S, f func(E1) (E2, error)) ([]E2
 	return v, nil
 }
 
+// ApplyToAllConcurrentlyWithError returns a new slice containing the results of applying the function `f` to each element of the original slice `s`.
+// At most `n` applications of `f` run concurrently. The results are in the same order as the elements of `s`.
+// All errors are returned, joined.
+func ApplyToAllConcurrentlyWithError[S ~[]E1, E1, E2 any](s S, n int, f func(E1) (E2, error)) ([]E2, error) {
+	if n < 1 {
+		n = 1
+	}
+
+	v := make([]E2, len(s))
+	errs := make([]error, len(s))
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+
+	for i, e1 := range s {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, e1 E1) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			v[i], errs[i] = f(e1)
+		}(i, e1)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // Values returns a new slice containing values from the pointers in each element of the original slice `s`.
 func Values[S ~[]*E, E any](s S) []E {
 	return ApplyToAll(s, func(e *E) E {
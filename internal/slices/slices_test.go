@@ -4,6 +4,7 @@
 package slices
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -156,6 +157,60 @@ func TestApplyToAll(t *testing.T) {
 	}
 }
 
+func TestApplyToAllConcurrentlyWithError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       []string
+		concurrency int
+		expected    []string
+		expectedErr bool
+	}
+	tests := map[string]testCase{
+		"three elements": {
+			input:       []string{"one", "two", "3"},
+			concurrency: 2,
+			expected:    []string{"ONE", "TWO", "3"},
+		},
+		"no concurrency": {
+			input:    []string{"one", "two", "3"},
+			expected: []string{"ONE", "TWO", "3"},
+		},
+		"zero elements": {
+			input:       []string{},
+			concurrency: 2,
+			expected:    []string{},
+		},
+		"error": {
+			input:       []string{"one", "", "3"},
+			concurrency: 3,
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyToAllConcurrentlyWithError(test.input, test.concurrency, func(v string) (string, error) {
+				if v == "" {
+					return "", errors.New("empty")
+				}
+				return strings.ToUpper(v), nil
+			})
+
+			if gotErr := err != nil; gotErr != test.expectedErr {
+				t.Fatalf("err %t, want %t: %s", gotErr, test.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestChunk(t *testing.T) {
 	t.Parallel()
 
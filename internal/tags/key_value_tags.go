@@ -33,6 +33,28 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes holds the Terraform resource types to which the configuration does not apply.
+	ExcludeResourceTypes []string
+	// RequiredKeys holds the tag keys that every tagged resource must have.
+	RequiredKeys []string
+	// TagPolicy holds the effective AWS Organizations tag policy, if any.
+	TagPolicy *Policy
+	// TagPolicyCompliance holds the action to take when tags do not comply with TagPolicy.
+	TagPolicyCompliance string
+}
+
+const (
+	TagPolicyComplianceDisabled = "disabled"
+	TagPolicyComplianceError    = "error"
+	TagPolicyComplianceWarning  = "warning"
+)
+
+func TagPolicyComplianceValues() []string {
+	return []string{
+		TagPolicyComplianceDisabled,
+		TagPolicyComplianceError,
+		TagPolicyComplianceWarning,
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,6 +78,49 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the configuration that applies to the specified Terraform resource type.
+// nil is returned if the resource type is excluded.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	for _, v := range dc.ExcludeResourceTypes {
+		if v == typeName {
+			return nil
+		}
+	}
+
+	return dc
+}
+
+// MissingRequiredKeys returns the configuration's RequiredKeys that are not present in the given tags.
+func (dc *DefaultConfig) MissingRequiredKeys(tags KeyValueTags) []string {
+	if dc == nil {
+		return nil
+	}
+
+	var missing []string
+
+	for _, key := range dc.RequiredKeys {
+		if _, ok := tags[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
+
+// TagPolicyViolations returns a description of each way in which the given tags do not comply
+// with the configuration's TagPolicy. No violations are returned if tag policy compliance is disabled.
+func (dc *DefaultConfig) TagPolicyViolations(tags KeyValueTags) []string {
+	if dc == nil || dc.TagPolicyCompliance == "" || dc.TagPolicyCompliance == TagPolicyComplianceDisabled {
+		return nil
+	}
+
+	return dc.TagPolicy.Violations(tags)
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
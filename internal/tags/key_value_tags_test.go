@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -317,6 +318,100 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	defaultConfig := &DefaultConfig{
+		ExcludeResourceTypes: []string{"aws_vpc"},
+	}
+
+	testCases := []struct {
+		name          string
+		typeName      string
+		defaultConfig *DefaultConfig
+		want          *DefaultConfig
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_subnet",
+		},
+		{
+			name:          "included",
+			typeName:      "aws_subnet",
+			defaultConfig: defaultConfig,
+			want:          defaultConfig,
+		},
+		{
+			name:          "excluded",
+			typeName:      "aws_vpc",
+			defaultConfig: defaultConfig,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.defaultConfig.ForResourceType(testCase.typeName), testCase.want; got != want {
+				t.Errorf("got %v; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigMissingRequiredKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          []string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "all present",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredKeys: []string{"key1", "key2"},
+			},
+		},
+		{
+			name: "some missing",
+			tags: New(ctx, map[string]string{
+				"Key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredKeys: []string{"key1", "key2"},
+			},
+			want: []string{"key1", "key2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.MissingRequiredKeys(testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %v; want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
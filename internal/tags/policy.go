@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Policy contains the rules of an AWS Organizations tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax-reference.html.
+type Policy struct {
+	// Rules is keyed by lower-case tag key.
+	Rules map[string]PolicyRule
+}
+
+// PolicyRule contains the compliance rule for a single tag key.
+type PolicyRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+	// Values holds the allowed tag values. A trailing "*" matches any suffix.
+	// An empty list allows any value.
+	Values []string
+}
+
+// ParsePolicy parses the JSON content of an effective tag policy.
+// Both the effective policy syntax and the "@@assign" inheritance operator syntax are accepted.
+func ParsePolicy(content string) (*Policy, error) {
+	var document struct {
+		Tags map[string]map[string]json.RawMessage `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	policy := &Policy{
+		Rules: make(map[string]PolicyRule, len(document.Tags)),
+	}
+
+	for name, tfMap := range document.Tags {
+		rule := PolicyRule{
+			Key: name,
+		}
+
+		if v, ok := tfMap["tag_key"]; ok {
+			keys, err := policyOperatorValues(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("parsing tag policy (%s) tag_key: %w", name, err)
+			}
+
+			if len(keys) > 0 {
+				rule.Key = keys[0]
+			}
+		}
+
+		if v, ok := tfMap["tag_value"]; ok {
+			values, err := policyOperatorValues(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("parsing tag policy (%s) tag_value: %w", name, err)
+			}
+
+			rule.Values = values
+		}
+
+		policy.Rules[strings.ToLower(name)] = rule
+	}
+
+	return policy, nil
+}
+
+// policyOperatorValues returns the values of a tag policy element.
+// The element is either a string, a list of strings or an object with an "@@assign" operator.
+func policyOperatorValues(raw json.RawMessage) ([]string, error) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values, nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &operators); err != nil {
+		return nil, err
+	}
+
+	if v, ok := operators["@@assign"]; ok {
+		return policyOperatorValues(v)
+	}
+
+	return nil, nil
+}
+
+// Violations returns a description of each way in which the given tags do not comply with the policy.
+// Tag key capitalization and tag values are checked.
+func (p *Policy) Violations(tags KeyValueTags) []string {
+	if p == nil {
+		return nil
+	}
+
+	var violations []string
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		rule, ok := p.Rules[strings.ToLower(key)]
+
+		if !ok {
+			continue
+		}
+
+		if key != rule.Key {
+			violations = append(violations, fmt.Sprintf("tag key %q must be capitalized as %q", key, rule.Key))
+			continue
+		}
+
+		if len(rule.Values) == 0 {
+			continue
+		}
+
+		value := tags.KeyValue(key)
+		var valueString string
+		if value != nil {
+			valueString = *value
+		}
+
+		if !slices.ContainsFunc(rule.Values, func(allowed string) bool {
+			if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+				return strings.HasPrefix(valueString, prefix)
+			}
+			return valueString == allowed
+		}) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values (%s)", key, valueString, strings.Join(rule.Values, ", ")))
+		}
+	}
+
+	return violations
+}
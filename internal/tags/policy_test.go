@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		want    *Policy
+		wantErr bool
+	}{
+		{
+			name:    "invalid JSON",
+			content: `{`,
+			wantErr: true,
+		},
+		{
+			name:    "no tags",
+			content: `{}`,
+			want: &Policy{
+				Rules: map[string]PolicyRule{},
+			},
+		},
+		{
+			name:    "effective policy",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100","200*"],"enforced_for":["ec2:instance"]},"project":{"tag_key":"Project"}}}`,
+			want: &Policy{
+				Rules: map[string]PolicyRule{
+					"costcenter": {
+						Key:    "CostCenter",
+						Values: []string{"100", "200*"},
+					},
+					"project": {
+						Key: "Project",
+					},
+				},
+			},
+		},
+		{
+			name:    "inheritance operators",
+			content: `{"tags":{"CostCenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100"]}}}}`,
+			want: &Policy{
+				Rules: map[string]PolicyRule{
+					"costcenter": {
+						Key:    "CostCenter",
+						Values: []string{"100"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePolicy(testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParsePolicy() err %t, want %t: %s", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &Policy{
+		Rules: map[string]PolicyRule{
+			"costcenter": {
+				Key:    "CostCenter",
+				Values: []string{"100", "200*"},
+			},
+			"project": {
+				Key: "Project",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		policy *Policy
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name: "no policy",
+			tags: New(ctx, map[string]string{
+				"costcenter": "999",
+			}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Other":      "value",
+				"Project":    "anything",
+			}),
+		},
+		{
+			name:   "wildcard value",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "2001",
+			}),
+		},
+		{
+			name:   "key capitalization",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"project": "anything",
+			}),
+			want: []string{
+				`tag key "project" must be capitalized as "Project"`,
+			},
+		},
+		{
+			name:   "value not allowed",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+			}),
+			want: []string{
+				`tag "CostCenter" value "300" is not one of the allowed values (100, 200*)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
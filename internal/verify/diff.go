@@ -6,8 +6,10 @@ package verify
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -21,10 +23,17 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// An error is also returned if the merged tags are missing any required tag keys
+// or do not comply with the provider's tag policy.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	// The provider-level configuration in Context takes into account any resource type exclusions.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
@@ -42,6 +51,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if err := checkTagsCompliance(ctx, defaultTagsConfig, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -103,6 +116,27 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// checkTagsCompliance returns an error if the specified tags are missing any required tag keys
+// or, depending on the configured compliance action, do not comply with the tag policy.
+func checkTagsCompliance(ctx context.Context, defaultTagsConfig *tftags.DefaultConfig, tags tftags.KeyValueTags) error {
+	if missing := defaultTagsConfig.MissingRequiredKeys(tags); len(missing) > 0 {
+		return fmt.Errorf("missing required tag keys: %s", strings.Join(missing, ", "))
+	}
+
+	if violations := defaultTagsConfig.TagPolicyViolations(tags); len(violations) > 0 {
+		if defaultTagsConfig.TagPolicyCompliance == tftags.TagPolicyComplianceWarning {
+			tflog.Warn(ctx, "tags do not comply with tag policy", map[string]any{
+				"violations": violations,
+			})
+			return nil
+		}
+
+		return fmt.Errorf("tags do not comply with tag policy: %s", strings.Join(violations, "; "))
+	}
+
+	return nil
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {